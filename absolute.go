@@ -0,0 +1,148 @@
+package humantime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AbsoluteParser parses absolute dates like "May 8, 2009 5:57:51 PM" or
+// "2009-05-08T17:57:51Z". Humantime calls it with the whole date phrase before
+// trying its own relative grammar (yesterday, next friday, 3pm ...) so it should
+// return an error for anything it does not fully understand.
+type AbsoluteParser interface {
+	ParseAbsolute(input string, loc *time.Location) (time.Time, error)
+}
+
+// LayoutParser is the built in AbsoluteParser, it tries each Go time layout
+// in order and returns the first that matches. Month and day names are matched
+// case insensitively so lower cased input works.
+type LayoutParser struct {
+	Layouts []string
+}
+
+// NewLayoutParser returns a LayoutParser using DefaultLayouts
+func NewLayoutParser() *LayoutParser {
+	return &LayoutParser{Layouts: DefaultLayouts()}
+}
+
+// DefaultLayouts returns a fresh copy of the layouts LayoutParser uses by default:
+//
+//	RFC 3339:          2006-01-02T15:04:05Z07:00, with fractional seconds or without seconds
+//	ISO 8601 extended: 2006-01-02T15:04:05, 2006-01-02T15:04, 2006-01-02 15:04:05, 2006-01-02
+//	year and month:    2006-01, 2006
+//	ISO 8601 basic:    20060102T150405Z0700, 20060102T150405, 20060102T1504, 20060102
+//	RFC 1123:          Mon, 02 Jan 2006 15:04:05 MST, also with a numeric zone
+//	RFC 850, RFC 822, ANSIC, Unix date and Ruby date
+//	US numeric:        1/2/2006 3:04:05 PM, 1/2/2006 3:04 PM, 1/2/2006 15:04:05, 1/2/2006
+//	EU numeric:        2.1.2006 15:04:05, 2.1.2006 15:04, 2.1.2006
+//	month names:       January 2, 2006 3:04:05 PM, Jan 2, 2006 15:04, 2 January 2006 ...
+//	without a year:    January 2, Jan 2
+//
+// Dates without a year are parsed in year 0, Humantime moves them to the
+// current year. Humantime reads numeric dates like 3/4/2006 itself according to its
+// DateOrder, the numeric layouts are here for using LayoutParser on its own.
+func DefaultLayouts() []string {
+	return []string{
+		// RFC 3339 and ISO 8601 extended
+		time.RFC3339Nano,
+		time.RFC3339,
+		"2006-01-02T15:04Z07:00",
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02T15:04:05",
+		"2006-01-02T15:04",
+		"2006-01-02 15:04:05Z07:00",
+		"2006-01-02 15:04:05",
+		"2006-01-02 15:04",
+		"2006-01-02",
+		"2006-01",
+		"2006",
+
+		// ISO 8601 basic
+		"20060102T150405Z0700",
+		"20060102T150405Z",
+		"20060102T150405",
+		"20060102T1504",
+		"20060102",
+
+		// RFC and unix formats
+		time.RFC1123,
+		time.RFC1123Z,
+		time.RFC850,
+		time.RFC822,
+		time.RFC822Z,
+		time.ANSIC,
+		time.UnixDate,
+		time.RubyDate,
+
+		// US numeric
+		"1/2/2006 3:04:05 PM",
+		"1/2/2006 3:04 PM",
+		"1/2/2006 15:04:05",
+		"1/2/2006 15:04",
+		"1/2/2006",
+
+		// EU numeric
+		"2.1.2006 15:04:05",
+		"2.1.2006 15:04",
+		"2.1.2006",
+
+		// month names
+		"January 2, 2006 3:04:05 PM",
+		"January 2, 2006 3:04 PM",
+		"January 2, 2006 15:04:05",
+		"January 2, 2006 15:04",
+		"January 2, 2006",
+		"January 2 2006",
+		"Jan 2, 2006 3:04:05 PM",
+		"Jan 2, 2006 3:04 PM",
+		"Jan 2, 2006 15:04:05",
+		"Jan 2, 2006 15:04",
+		"Jan 2, 2006",
+		"Jan 2 2006",
+		"Mon, Jan 2, 2006",
+		"Monday, January 2, 2006",
+		"2 January 2006 15:04:05",
+		"2 January 2006 15:04",
+		"2 January 2006",
+		"2 Jan 2006 15:04:05",
+		"2 Jan 2006 15:04",
+		"2 Jan 2006",
+		"January 2006",
+		"Jan 2006",
+		"January 2",
+		"Jan 2",
+	}
+}
+
+// layoutPrecisions are the layouts that name a whole month or year, every
+// other layout is a day, or a second when it has a time of day
+var layoutPrecisions = map[string]Precision{
+	"January 2006": PrecisionMonth,
+	"Jan 2006":     PrecisionMonth,
+	"2006-01":      PrecisionMonth,
+	"2006":         PrecisionYear,
+}
+
+// ParseAbsolute fulfills the AbsoluteParser interface
+func (lp *LayoutParser) ParseAbsolute(input string, loc *time.Location) (time.Time, error) {
+	var t, _, err = lp.parse(input, loc)
+	return t, err
+}
+
+// parse is ParseAbsolute that also returns the layout that matched
+func (lp *LayoutParser) parse(input string, loc *time.Location) (time.Time, string, error) {
+	input = strings.TrimSpace(input)
+
+	// literals like the T and Z in RFC 3339 are case sensitive, month names are not
+	var upper = strings.ToUpper(input)
+	for _, layout := range lp.Layouts {
+		if t, err := time.ParseInLocation(layout, input, loc); err == nil {
+			return t, layout, nil
+		}
+		if t, err := time.ParseInLocation(layout, upper, loc); err == nil {
+			return t, layout, nil
+		}
+	}
+	return time.Time{}, "", fmt.Errorf("no layout matches: %s", input)
+}
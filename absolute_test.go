@@ -0,0 +1,89 @@
+package humantime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var TestLayoutParserTestCases = map[string]time.Time{
+	"2009-05-08T17:57:51Z":             time.Date(2009, time.May, 8, 17, 57, 51, 0, time.UTC),
+	"2009-05-08t17:57:51.5z":           time.Date(2009, time.May, 8, 17, 57, 51, 500000000, time.UTC),
+	"2009-05-08T17:57Z":                time.Date(2009, time.May, 8, 17, 57, 0, 0, time.UTC),
+	"2009-05-08T17:57:51":              time.Date(2009, time.May, 8, 17, 57, 51, 0, time.UTC),
+	"20090508T175751Z":                 time.Date(2009, time.May, 8, 17, 57, 51, 0, time.UTC),
+	"20090508t1757":                    time.Date(2009, time.May, 8, 17, 57, 0, 0, time.UTC),
+	"20090508":                         time.Date(2009, time.May, 8, 0, 0, 0, 0, time.UTC),
+	"Fri, 08 May 2009 17:57:51 UTC":    time.Date(2009, time.May, 8, 17, 57, 51, 0, time.UTC),
+	"fri, 08 may 2009 17:57:51 +0000":  time.Date(2009, time.May, 8, 17, 57, 51, 0, time.UTC),
+	"5/8/2009 5:57:51 PM":              time.Date(2009, time.May, 8, 17, 57, 51, 0, time.UTC),
+	"8.5.2009 17:57":                   time.Date(2009, time.May, 8, 17, 57, 0, 0, time.UTC),
+	"May 8, 2009 5:57:51 PM":           time.Date(2009, time.May, 8, 17, 57, 51, 0, time.UTC),
+	"september 12, 2021 3:21 pm":       time.Date(2021, time.September, 12, 15, 21, 0, 0, time.UTC),
+	"Sep 12 2021":                      time.Date(2021, time.September, 12, 0, 0, 0, 0, time.UTC),
+	"12 sep 2021 15:21:22":             time.Date(2021, time.September, 12, 15, 21, 22, 0, time.UTC),
+	"Sunday, September 12, 2021":       time.Date(2021, time.September, 12, 0, 0, 0, 0, time.UTC),
+	"Sep 2021":                         time.Date(2021, time.September, 1, 0, 0, 0, 0, time.UTC),
+	"2024-03":                          time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+	"2020":                             time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+	"Sun Sep 12 15:21:22 UTC 2021":     time.Date(2021, time.September, 12, 15, 21, 22, 0, time.UTC),
+	"Sun Sep 12 15:21:22 +0000 2021":   time.Date(2021, time.September, 12, 15, 21, 22, 0, time.UTC),
+	"  January 2, 2006 15:04:05      ": time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC),
+}
+
+func TestLayoutParser(t *testing.T) {
+	t.Parallel()
+
+	var lp = NewLayoutParser()
+	for input, expected := range TestLayoutParserTestCases {
+		result, err := lp.ParseAbsolute(input, time.UTC)
+		assert.NoError(t, err, input)
+		assert.True(t, expected.Equal(result), input)
+	}
+
+	result, err := lp.ParseAbsolute("next friday", time.UTC)
+	assert.Equal(t, "no layout matches: next friday", err.Error())
+	assert.Equal(t, time.Time{}, result)
+
+	// DefaultLayouts hands out copies
+	DefaultLayouts()[0] = "nope"
+	assert.Equal(t, time.RFC3339Nano, DefaultLayouts()[0])
+}
+
+type fixedParser time.Time
+
+func (fp fixedParser) ParseAbsolute(input string, loc *time.Location) (time.Time, error) {
+	if input != "the big day" {
+		return time.Time{}, errors.New("not the big day")
+	}
+	return time.Time(fp).In(loc), nil
+}
+
+func TestAbsoluteParser(t *testing.T) {
+	t.Parallel()
+
+	// custom layouts are tried first
+	var st, err = New(WithLocation(time.UTC), WithLayouts("2006 Jan 2 @ 15h04"))
+	assert.NoError(t, err)
+	result, err := st.Parse("since 2021 sep 12 @ 15h21")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, time.September, 12, 15, 21, 0, 0, time.UTC), result.From)
+
+	// custom parser, the relative grammar still works
+	var bigDay = time.Date(2030, time.June, 1, 0, 0, 0, 0, time.UTC)
+	st, err = New(WithLocation(time.UTC), WithAbsoluteParser(fixedParser(bigDay)))
+	assert.NoError(t, err)
+	result, err = st.Parse("before the big day")
+	assert.NoError(t, err)
+	assert.Equal(t, bigDay, result.To)
+
+	result, err = st.Parse("since yesterday")
+	assert.NoError(t, err)
+	var now = time.Now().UTC()
+	assert.Equal(t, time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, time.UTC), result.From)
+
+	_, err = st.Parse("since May 8, 2009")
+	assert.Equal(t, "could not parse may 8, 2009", err.Error())
+}
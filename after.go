@@ -3,11 +3,12 @@ package humantime
 import (
 	"errors"
 	"strings"
-	"time"
 )
 
 // After takes a string starting with the word after
-// and parses the remainder as time.Time, examples:
+// and parses the remainder as the start of a range with no end. The range
+// starts once the period is over so "after friday" starts on saturday and
+// "after 3pm" right after 3pm, examples:
 // after 3/15/2022
 // after May 8, 2009 5:57:51 PM
 // after 2am
@@ -15,8 +16,7 @@ import (
 // after yesterday at 4pm
 // after yesterday at 13:34:32
 func (st *Humantime) After(input string) (*TimeRange, error) {
-	var tr = new(TimeRange)
-	tr.To = time.Now().In(st.Location)
+	var tr = &TimeRange{ToUnbounded: true}
 
 	if len(strings.Fields(input)) < 2 {
 		return nil, errors.New("input must have at least two fields")
@@ -25,7 +25,11 @@ func (st *Humantime) After(input string) (*TimeRange, error) {
 		return nil, errors.New("input does not start with 'after'")
 	}
 
-	var err error
-	tr.From, err = st.parseDatePhrase(strings.ReplaceAll(input, "after ", ""))
+	var date, precision, err = st.preferring(PreferPast).parsePhrase(strings.TrimPrefix(input, "after "))
+	if precision < PrecisionDay {
+		tr.From, tr.FromExclusive = date, true
+	} else {
+		tr.From = st.periodEnd(date, precision)
+	}
 	return tr, err
 }
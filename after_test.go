@@ -8,30 +8,41 @@ import (
 )
 
 var TestAfterTestCases = map[string]time.Time{
-	"after 3/15/2022":              time.Date(2022, time.Month(3), 15, 0, 0, 0, 0, today.Location()),
+	"after 3/15/2022":              time.Date(2022, time.Month(3), 16, 0, 0, 0, 0, today.Location()),
 	"after May 8, 2009 5:57:51 PM": time.Date(2009, time.Month(5), 8, 17, 57, 51, 0, today.Location()),
-	"after yesterday":              time.Date(today.Year(), today.Month(), today.Day()-1, 0, 0, 0, 0, today.Location()),
+	"after yesterday":              time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location()),
 	"after yesterday at 4pm":       time.Date(today.Year(), today.Month(), today.Day()-1, 16, 0, 0, 0, today.Location()),
 	"after yesterday at 13:34:32":  time.Date(today.Year(), today.Month(), today.Day()-1, 13, 34, 32, 0, today.Location()),
-	"after 2am":                    time.Date(today.Year(), today.Month(), today.Day(), 02, 00, 00, 0, today.Location()),
+	"after march 2024":             time.Date(2024, time.April, 1, 0, 0, 0, 0, today.Location()),
+	"after Jan 2025":               time.Date(2025, time.February, 1, 0, 0, 0, 0, today.Location()),
+	"after 2020":                   time.Date(2021, time.January, 1, 0, 0, 0, 0, today.Location()),
 }
 
 func TestAfter(t *testing.T) {
 	t.Parallel()
 
 	var now = time.Now()
-	var st, err = NewString2Time(now.Location())
+	var st, err = New(WithLocation(now.Location()))
 	assert.NoError(t, err)
 
 	for input, expected := range TestAfterTestCases {
 		result, err := st.After(input)
 		assert.NoError(t, err)
 		assert.Equal(t, expected, result.From)
-		assert.Equal(t, now.Round(time.Second), result.To.Round(time.Second))
+		assert.False(t, result.FromUnbounded)
+		assert.True(t, result.ToUnbounded)
+		assert.True(t, result.To.IsZero())
 	}
 
 	// error cases
-	result, err := st.After("after ")
+
+	// a bare time of day is resolved against the clock, 10:30 in fixedNow
+	var _, fixed = fixedNow(t)
+	result, err := fixed.After("after 2am")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 6, 2, 0, 0, 0, time.UTC), result.From)
+
+	result, err = st.After("after ")
 	assert.Equal(t, "input must have at least two fields", err.Error())
 	assert.Nil(t, result)
 
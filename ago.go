@@ -3,7 +3,6 @@ package humantime
 import (
 	"errors"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -14,8 +13,19 @@ import (
 // 3 hours ago
 // 8 days and three hours ago
 // 1 year 2 months 3 days 4 hours 5 minutes 6 seconds ago
+// 3d ago
+// 2h30m ago
 func (st *Humantime) Ago(input string) (*TimeRange, error) {
 	var tr = new(TimeRange)
+	tr.To = st.now()
+
+	// compact durations like "2h30m" are a single field
+	if rest, found := strings.CutSuffix(input, " ago"); found {
+		if u, ok := compactLength(rest); ok {
+			tr.From = ago(tr.To, u)
+			return tr, nil
+		}
+	}
 
 	// lint the input
 	if len(strings.Fields(input)) < 3 {
@@ -37,86 +47,70 @@ func (st *Humantime) Ago(input string) (*TimeRange, error) {
 		return nil, fmt.Errorf("number of input fields must be even: %s", input)
 	}
 
-	var baseTime, nextString, err = parseLargeUnits(inputCopy, st.Location)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing large units: %s, err: %w", input, err)
-	}
-
-	duration, err := parseSmallUnits(nextString)
+	var u, err = st.vocab.load().parseUnits(inputArr)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing small units: %s, err: %w", input, err)
+		return nil, fmt.Errorf("error parsing units: %s, err: %w", input, err)
 	}
 
-	tr.From = *baseTime
-	tr.From = tr.From.Add(duration * -1)
-	tr.To = time.Now().In(st.Location)
+	tr.From = ago(tr.To, u)
 
 	return tr, nil
 }
 
-// parseLargeUnits takes the input string and parses out years, months and days.
-// It returns a Time pointer corresponding to now - [result of parsing].
-// It also returns the rest of the input string i.e. (full string - the part parsed).
-// The string should then be passed to parseSmallUnits().
-func parseLargeUnits(input string, loc *time.Location) (*time.Time, string, error) {
-	var year int
-	var month int
-	var day int
-	var tempNum int
-	var lastIndex int
-	var inputArr = strings.Fields(input)
+// ago is u before now counted from the whole second, so "3h ago", "3 hours ago"
+// and "since 3 hours ago" all land on the same instant
+func ago(now time.Time, u Duration) time.Time {
+	return u.SubFrom(now.Truncate(time.Second))
+}
 
-	var matched, err = regexp.MatchString(`year|month|day`, input)
-	if err != nil {
-		return nil, "", err
-	}
-	if matched {
-		for i, word := range strings.Fields(input) {
-			if i%2 == 0 {
-				tempNum, err = strconv.Atoi(word)
-				if err != nil {
-					return nil, "", fmt.Errorf("error parsing time: %s, err: %w", input, err)
-				}
-			} else {
-				if found := strings.Contains(word, "year"); found {
-					year = tempNum
-					lastIndex = i
-				} else if found := strings.Contains(word, "month"); found {
-					month = tempNum
-					lastIndex = i
-				} else if found := strings.Contains(word, "day"); found {
-					day = tempNum
-					lastIndex = i
-				}
-			}
+// parseUnits reads pairs of numbers and units e.g. ["1", "year", "2", "hours"]
+// or ["a", "week"] and adds them up
+func (v *Vocabulary) parseUnits(fields []string) (Duration, error) {
+	var total Duration
+	for i := 0; i+1 < len(fields); i += 2 {
+		var num, err = parseCount(fields[i])
+		if err != nil {
+			return Duration{}, fmt.Errorf("error parsing number: %s, err: %w", fields[i], err)
 		}
+		var u, found = v.units[fields[i+1]]
+		if !found {
+			return Duration{}, fmt.Errorf("unknown unit: %s", fields[i+1])
+		}
+		total = total.Add(u.times(num))
 	}
-
-	var today = time.Now()
-	var t = time.Date(today.Year()-year, today.Month()-time.Month(month), today.Day()-day, today.Hour(), today.Minute(), today.Second(), 0, loc)
-	return &t, strings.Join(inputArr[lastIndex+1:], " "), nil
+	return total, nil
 }
 
-// parseSmallUnits takes the input string and parses out hours, minutes and seconds.
-// It does this by converting the string to the time.Duration string format: DhDmDs
-func parseSmallUnits(input string) (time.Duration, error) {
-
-	var matched, err = regexp.MatchString(`hour|minute|second`, input)
-	if err != nil {
-		return 0, err
-	}
-	if !matched {
-		return 0, nil
+// parseCount reads the number in front of a unit, "a", "an", "one" and "the"
+// count as 1 as in "an hour ago" or "the day after tomorrow"
+func parseCount(word string) (int, error) {
+	switch word {
+	case "a", "an", "one", "the":
+		return 1, nil
 	}
+	return strconv.Atoi(word)
+}
 
-	var hr = regexp.MustCompile(`hour(s)?`)
-	input = hr.ReplaceAllString(input, "h")
-
-	var mr = regexp.MustCompile(`minute(s)?`)
-	input = mr.ReplaceAllString(input, "m")
-
-	var sr = regexp.MustCompile(`second(s)?`)
-	input = sr.ReplaceAllString(input, "s")
+// parseLength reads a duration in words like "1 hour and 30 minutes" or
+// compact like "2h30m", ok is false when the input is neither. Signs are left
+// to the caller.
+func (v *Vocabulary) parseLength(input string) (Duration, bool) {
+	var fields = unitFields(input)
+	if len(fields) > 0 && len(fields)%2 == 0 {
+		if d, err := v.parseUnits(fields); err == nil {
+			return d, true
+		}
+	}
+	return compactLength(input)
+}
 
-	return time.ParseDuration(strings.ReplaceAll(input, " ", ""))
+// unitFields splits "1 year, 2 months and 3 days" into its numbers and units
+func unitFields(input string) []string {
+	var fields []string
+	for _, field := range strings.Fields(strings.ReplaceAll(input, ",", " ")) {
+		if field != "and" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
 }
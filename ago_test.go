@@ -1,6 +1,7 @@
 package humantime
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 var TestAgoTestCases = map[string]time.Time{
 	"3 days ago":   time.Date(today.Year(), today.Month(), today.Day()-3, today.Hour(), today.Minute(), today.Second(), 0, today.Location()),
+	"an hour ago":  time.Date(today.Year(), today.Month(), today.Day(), today.Hour()-1, today.Minute(), today.Second(), 0, today.Location()),
 	"14 years ago": time.Date(today.Year()-14, today.Month(), today.Day(), today.Hour(), today.Minute(), today.Second(), 0, today.Location()),
 	"1 year 2 months 3 days 4 hours 5 minutes 6 seconds ago": time.Date(today.Year()-1, today.Month()-time.Month(2), today.Day()-3, today.Hour()-4, today.Minute()-5, today.Second()-6, 0, today.Location()),
 }
@@ -17,7 +19,7 @@ func TestAgo(t *testing.T) {
 	t.Parallel()
 
 	var now = time.Now()
-	var st, err = NewString2Time(now.Location())
+	var st, err = New(WithLocation(now.Location()))
 	assert.NoError(t, err)
 
 	for input, expected := range TestAgoTestCases {
@@ -36,11 +38,11 @@ func TestAgo(t *testing.T) {
 	assert.Nil(t, result)
 
 	result, err = st.Ago("DD years ago")
-	assert.Equal(t, "error parsing large units: DD years ago, err: error parsing time: DD years, err: strconv.Atoi: parsing \"DD\": invalid syntax", err.Error())
+	assert.Equal(t, "error parsing units: DD years ago, err: error parsing number: DD, err: strconv.Atoi: parsing \"DD\": invalid syntax", err.Error())
 	assert.Nil(t, result)
 
-	result, err = st.Ago("DD seconds ago")
-	assert.Equal(t, "error parsing small units: DD seconds ago, err: time: invalid duration \"s\"", err.Error())
+	result, err = st.Ago("3 fortnights ago")
+	assert.Equal(t, "error parsing units: 3 fortnights ago, err: unknown unit: fortnights", err.Error())
 	assert.Nil(t, result)
 
 	result, err = st.Ago("14 years before")
@@ -48,23 +50,82 @@ func TestAgo(t *testing.T) {
 	assert.Nil(t, result)
 }
 
-func TestParseLargeUnits(t *testing.T) {
+func TestParseUnits(t *testing.T) {
 	t.Parallel()
 
-	var today = time.Now()
-	var expected = time.Date(today.Year()-1, today.Month()-time.Month(2), today.Day()-3, today.Hour(), today.Minute(), today.Second(), 0, today.Location())
-	var result, nextString, err = parseLargeUnits("1 year 2 months 3 days 4 hours 5 minutes 6 seconds", today.Location())
+	var result, err = DefaultVocabulary().parseUnits(strings.Fields("1 year 2 months 3 days 1 week 4 hours 5 mins 6 seconds"))
 	assert.NoError(t, err)
-	assert.Equal(t, &expected, result)
-	assert.Equal(t, "4 hours 5 minutes 6 seconds", nextString)
+	assert.Equal(t, Duration{Years: 1, Months: 2, Days: 10, Clock: 4*time.Hour + 5*time.Minute + 6*time.Second}, result)
+
+	// AddDate normalizes February 31st to March 2nd
+	var march = time.Date(2024, time.March, 31, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC), Duration{Months: 1}.SubFrom(march))
+	assert.Equal(t, time.Date(2024, time.March, 30, 11, 0, 0, 0, time.UTC), Duration{Days: 1, Clock: time.Hour}.SubFrom(march))
 }
 
-func TestParseSmallUnits(t *testing.T) {
+func TestCompactDurations(t *testing.T) {
 	t.Parallel()
 
-	var result, err = parseSmallUnits("4 hours 5 minutes 6 seconds")
-	assert.NoError(t, err)
-	d, err := time.ParseDuration("4h5m6s")
+	var now, st = fixedNow(t)
+
+	var ago = map[string]time.Time{
+		"3d ago":     time.Date(2024, time.March, 3, 10, 30, 0, 0, time.UTC),
+		"2h30m ago":  time.Date(2024, time.March, 6, 8, 0, 0, 0, time.UTC),
+		"2h 30m ago": time.Date(2024, time.March, 6, 8, 0, 0, 0, time.UTC),
+		"1w2d ago":   time.Date(2024, time.February, 26, 10, 30, 0, 0, time.UTC),
+		"1mo ago":    time.Date(2024, time.February, 6, 10, 30, 0, 0, time.UTC),
+	}
+	for input, expected := range ago {
+		result, err := st.Ago(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, TimeRange{From: expected, To: now}, *result, input)
+	}
+
+	// anywhere a duration goes, a leading sign is from now
+	var dates = map[string]time.Time{
+		"-15m":                 time.Date(2024, time.March, 6, 10, 15, 0, 0, time.UTC),
+		"+1w":                  time.Date(2024, time.March, 13, 10, 30, 0, 0, time.UTC),
+		"-2h30m":               time.Date(2024, time.March, 6, 8, 0, 0, 0, time.UTC),
+		"+1d12h":               time.Date(2024, time.March, 7, 22, 30, 0, 0, time.UTC),
+		"3d ago":               time.Date(2024, time.March, 3, 10, 30, 0, 0, time.UTC),
+		"2h30m before friday":  time.Date(2024, time.February, 29, 21, 30, 0, 0, time.UTC),
+		"1w after next monday": time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC),
+		"90m from now":         time.Date(2024, time.March, 6, 12, 0, 0, 0, time.UTC),
+		"now - 1h30m + 5m":     time.Date(2024, time.March, 6, 9, 5, 0, 0, time.UTC),
+	}
+	for input, expected := range dates {
+		result, err := st.ParseTime(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	var ranges = map[string]TimeRange{
+		"since -15m":        {From: time.Date(2024, time.March, 6, 10, 15, 0, 0, time.UTC), To: now},
+		"until +1w":         {From: now, To: time.Date(2024, time.March, 13, 10, 30, 0, 0, time.UTC)},
+		"since 2h30m ago":   {From: time.Date(2024, time.March, 6, 8, 0, 0, 0, time.UTC), To: now},
+		"past 2h":           {From: time.Date(2024, time.March, 6, 8, 30, 0, 0, time.UTC), To: now},
+		"now - 2h thru now": {From: time.Date(2024, time.March, 6, 8, 30, 0, 0, time.UTC), To: now, ToInclusive: true},
+	}
+	for input, expected := range ranges {
+		result, err := st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+
+	value, err := st.Eval("tomorrow - 1h30m")
 	assert.NoError(t, err)
-	assert.Equal(t, d, result)
+	assert.Equal(t, time.Date(2024, time.March, 6, 22, 30, 0, 0, time.UTC), value.Time)
+
+	// every spelling of ago counts from the whole second
+	var _, fraction = fixedNow(t, WithClock(func() time.Time { return now.Add(500 * time.Millisecond) }))
+	var threeHoursAgo = time.Date(2024, time.March, 6, 7, 30, 0, 0, time.UTC)
+	for _, input := range []string{"3h ago", "3 hours ago"} {
+		result, err := fraction.Ago(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, threeHoursAgo, result.From, input)
+
+		result, err = fraction.Parse("since " + input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, threeHoursAgo, result.From, input)
+	}
 }
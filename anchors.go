@@ -0,0 +1,130 @@
+package humantime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// AddAnchor registers a named point in time like "code freeze" on this
+// Humantime, it can then be used anywhere a date phrase is accepted:
+// "since code freeze", "2 days before code freeze", "from code freeze to 5pm"
+func (st *Humantime) AddAnchor(name string, t time.Time) error {
+	return st.AddAnchorFunc(name, func(now time.Time) time.Time {
+		return t.In(now.Location())
+	})
+}
+
+// AddAnchorFunc registers a named point in time that depends on the reference
+// time, e.g. "standup" is 9:30am of the current day
+func (st *Humantime) AddAnchorFunc(name string, fn func(now time.Time) time.Time) error {
+	return st.vocab.update(func(v *Vocabulary) (*Vocabulary, error) { return v.AddAnchor(name, fn) })
+}
+
+// LoadAnchorsFile reads anchors from a JSON file, see LoadAnchors
+func (st *Humantime) LoadAnchorsFile(path string) error {
+	var file, err = os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return st.LoadAnchors(file)
+}
+
+// LoadAnchors reads anchors from a JSON object mapping names to date phrases:
+//
+//	{
+//	  "code freeze": "2024-03-01T00:00:00Z",
+//	  "standup": "today at 9:30am",
+//	  "release day": "2 days after code freeze"
+//	}
+//
+// Absolute dates are fixed points, relative phrases are evaluated against the
+// reference time each time the anchor is used. A phrase can use the anchors
+// above it in the file. Every phrase is checked before any anchor is added.
+func (st *Humantime) LoadAnchors(r io.Reader) error {
+	var entries, err = readAnchors(r)
+	if err != nil {
+		return err
+	}
+
+	// the reader and the phrases are handled without holding the vocabulary
+	// lock, if another registration happened in the meantime start over on top of it
+	for {
+		var base = st.vocab.load()
+		var v, err = st.anchorVocabulary(base, entries)
+		if err != nil {
+			return err
+		}
+		if st.vocab.swap(base, v) {
+			return nil
+		}
+	}
+}
+
+// anchorEntry is one name and date phrase from an anchors file
+type anchorEntry struct {
+	name, phrase string
+}
+
+// readAnchors decodes a JSON object of anchors keeping the order of the file
+func readAnchors(r io.Reader) ([]anchorEntry, error) {
+	var dec = json.NewDecoder(r)
+	if tok, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("error reading anchors: %w", err)
+	} else if tok != json.Delim('{') {
+		return nil, errors.New("anchors must be a JSON object")
+	}
+
+	var entries []anchorEntry
+	for dec.More() {
+		var tok, err = dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("error reading anchors: %w", err)
+		}
+		var entry = anchorEntry{name: tok.(string)} // object keys are always strings
+		if err := dec.Decode(&entry.phrase); err != nil {
+			return nil, fmt.Errorf("error reading anchor %s: %w", entry.name, err)
+		}
+		entries = append(entries, entry)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("error reading anchors: %w", err)
+	}
+	return entries, nil
+}
+
+// anchorVocabulary checks each phrase and returns v with the anchors added
+func (st *Humantime) anchorVocabulary(v *Vocabulary, entries []anchorEntry) (*Vocabulary, error) {
+	for _, entry := range entries {
+		// the phrase is evaluated with the vocabulary as it is now,
+		// so anchors can only refer to the ones before them
+		var ht = *st
+		ht.vocab = newVocabHolder(v)
+		if _, err := ht.parseDatePhrase(entry.phrase); err != nil {
+			return nil, fmt.Errorf("error parsing anchor %s: %w", entry.name, err)
+		}
+
+		name, err := normalizeWord(entry.name)
+		if err != nil {
+			return nil, err
+		}
+		v, err = v.addAnchor(name, func(now time.Time) (time.Time, error) {
+			var at = ht
+			at.clock = func() time.Time { return now }
+			var t, err = at.parseDatePhrase(entry.phrase)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("error parsing anchor %s: %w", name, err)
+			}
+			return t, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,131 @@
+package humantime
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnchors(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	assert.NoError(t, st.AddAnchor("Code  Freeze", time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)))
+	assert.NoError(t, st.AddAnchor("last deploy", time.Date(2024, time.March, 5, 17, 45, 0, 0, time.UTC)))
+	assert.NoError(t, st.AddAnchorFunc("standup", func(now time.Time) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day(), 9, 30, 0, 0, now.Location())
+	}))
+
+	var cases = map[string]TimeRange{
+		"since last deploy": {
+			From: time.Date(2024, time.March, 5, 17, 45, 0, 0, time.UTC),
+			To:   now,
+		},
+		"since 2 days before code freeze": {
+			From: time.Date(2024, time.February, 28, 12, 0, 0, 0, time.UTC),
+			To:   now,
+		},
+		"until 1 week and 2 hours after code freeze": {
+			From: now,
+			To:   time.Date(2024, time.March, 8, 14, 0, 0, 0, time.UTC),
+		},
+		"from standup to 5pm": {
+			From: time.Date(2024, time.March, 6, 9, 30, 0, 0, time.UTC),
+			To:   time.Date(2024, time.March, 6, 17, 0, 0, 0, time.UTC),
+		},
+		"from code freeze at 3pm to last deploy": {
+			From: time.Date(2024, time.March, 1, 15, 0, 0, 0, time.UTC),
+			To:   time.Date(2024, time.March, 5, 17, 45, 0, 0, time.UTC),
+		},
+	}
+	for input, expected := range cases {
+		result, err := st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+
+	_, err := st.Parse("since 2 days before the freeze")
+	assert.Equal(t, "could not parse the freeze", err.Error())
+
+	assert.Equal(t, "anchor standup cannot have a nil func", st.AddAnchorFunc("standup", nil).Error())
+	assert.Equal(t, "word cannot be empty", st.AddAnchor("  ", now).Error())
+}
+
+func TestLoadAnchors(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var path = filepath.Join(t.TempDir(), "anchors.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{
+		"code freeze": "2024-03-01T00:00:00Z",
+		"standup": "today at 9:30am",
+		"release day": "2 days after code freeze"
+	}`), 0600))
+	assert.NoError(t, st.LoadAnchorsFile(path))
+
+	result, err := st.Parse("from release day to standup")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC), result.From)
+	assert.Equal(t, time.Date(2024, time.March, 6, 9, 30, 0, 0, time.UTC), result.To)
+
+	// relative anchors follow the reference time
+	later, err := New(WithLocation(time.UTC), WithClock(func() time.Time { return now.AddDate(0, 0, 1) }))
+	assert.NoError(t, err)
+	assert.NoError(t, later.LoadAnchorsFile(path))
+	result, err = later.Parse("since standup")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 7, 9, 30, 0, 0, time.UTC), result.From)
+
+	// errors leave the vocabulary untouched
+	var errorCases = map[string]string{
+		`[]`:                              "anchors must be a JSON object",
+		``:                                "error reading anchors: EOF",
+		`{"a": 1}`:                        "error reading anchor a: json: cannot unmarshal number into Go value of type string",
+		`{"a": "today", "b": "nope"}`:     "error parsing anchor b: could not parse nope",
+		`{"a": "b", "b": "today"}`:        "error parsing anchor a: could not parse b",
+		`{"a": "today", "": "yesterday"}`: "word cannot be empty",
+	}
+	for input, expected := range errorCases {
+		fresh, err := New(WithLocation(time.UTC))
+		assert.NoError(t, err)
+		err = fresh.LoadAnchors(strings.NewReader(input))
+		assert.Equal(t, expected, err.Error(), input)
+		assert.Empty(t, fresh.Vocabulary().anchors, input)
+	}
+
+	assert.Error(t, st.LoadAnchorsFile(filepath.Join(t.TempDir(), "nope.json")))
+
+	// the reader is drained without holding the vocabulary lock
+	_, st = fixedNow(t)
+	var r = readFunc(func() {
+		assert.NoError(t, st.AddAnchor("launch", now))
+	})
+	assert.NoError(t, st.LoadAnchors(io.MultiReader(strings.NewReader(`{"standup": "today at 9:30am"`), r, strings.NewReader(`}`))))
+	assert.Contains(t, st.Vocabulary().anchors, "launch")
+	assert.Contains(t, st.Vocabulary().anchors, "standup")
+
+	// an anchor that fails to resolve is an error, not a panic
+	broken, err := st.Vocabulary().addAnchor("broken", func(time.Time) (time.Time, error) {
+		return time.Time{}, errors.New("error parsing anchor broken: could not parse nope")
+	})
+	assert.NoError(t, err)
+	var _, withBroken = fixedNow(t, WithVocabulary(broken))
+	_, err = withBroken.Parse("since broken")
+	assert.EqualError(t, err, "error parsing anchor broken: could not parse nope")
+}
+
+// readFunc is an empty io.Reader that calls itself when read
+type readFunc func()
+
+func (fn readFunc) Read([]byte) (int, error) {
+	fn()
+	return 0, io.EOF
+}
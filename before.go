@@ -3,11 +3,10 @@ package humantime
 import (
 	"errors"
 	"strings"
-	"time"
 )
 
 // Before takes a string starting with the word before
-// and parses the remainder as time.Time, examples:
+// and parses the remainder as the end of a range with no start, examples:
 // before 3/15/2022
 // before May 8, 2009 5:57:51 PM
 // before 2am
@@ -15,8 +14,7 @@ import (
 // before tomorrow at 4pm
 // before tomorrow at 13:34:32
 func (st *Humantime) Before(input string) (*TimeRange, error) {
-	var tr = new(TimeRange)
-	tr.From = time.Now().In(st.Location)
+	var tr = &TimeRange{FromUnbounded: true}
 
 	if len(strings.Fields(input)) < 2 {
 		return nil, errors.New("input must have at least two fields")
@@ -26,6 +24,6 @@ func (st *Humantime) Before(input string) (*TimeRange, error) {
 	}
 
 	var err error
-	tr.To, err = st.parseDatePhrase(strings.ReplaceAll(input, "before ", ""))
+	tr.To, err = st.preferring(PreferFuture).parseDatePhrase(strings.TrimPrefix(input, "before "))
 	return tr, err
 }
@@ -13,24 +13,34 @@ var TestBeforeTestCases = map[string]time.Time{
 	"before tomorrow":                 time.Date(today.Year(), today.Month(), today.Day()+1, 0, 0, 0, 0, today.Location()),
 	"before tomorrow at 4pm":          time.Date(today.Year(), today.Month(), today.Day()+1, 16, 0, 0, 0, today.Location()),
 	"before tomorrow at 13:34:32":     time.Date(today.Year(), today.Month(), today.Day()+1, 13, 34, 32, 0, today.Location()),
-	"before 2pm":                      time.Date(today.Year(), today.Month(), today.Day(), 14, 00, 00, 0, today.Location()),
 	"before next tuesday at 05:23:43": time.Date(today.Year(), today.Month(), today.Day()-int(today.Weekday()-time.Tuesday)+7, 5, 23, 43, 0, today.Location()),
+	"before 2020":                     time.Date(2020, time.January, 1, 0, 0, 0, 0, today.Location()),
+	"before march 2024":               time.Date(2024, time.March, 1, 0, 0, 0, 0, today.Location()),
 }
 
 func TestBefore(t *testing.T) {
 	t.Parallel()
 
 	var now = time.Now()
-	var st, err = NewString2Time(now.Location())
+	var st, err = New(WithLocation(now.Location()))
 	assert.NoError(t, err)
 
 	for input, expected := range TestBeforeTestCases {
 		result, err := st.Before(input)
 		assert.NoError(t, err)
-		assert.Equal(t, now.Round(time.Second), result.From.Round(time.Second))
+		assert.True(t, result.FromUnbounded)
+		assert.True(t, result.From.IsZero())
+		assert.False(t, result.ToUnbounded)
 		assert.Equal(t, expected, result.To)
 	}
-	result, err := st.Before("before")
+
+	// a bare time of day is resolved against the clock, 10:30 in fixedNow
+	var _, fixed = fixedNow(t)
+	result, err := fixed.Before("before 2pm")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 6, 14, 0, 0, 0, time.UTC), result.To)
+
+	result, err = st.Before("before")
 	assert.Equal(t, "input must have at least two fields", err.Error())
 	assert.Nil(t, result)
 
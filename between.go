@@ -0,0 +1,78 @@
+package humantime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Between takes a string in the format between [date phrase] and [date phrase],
+// it is the same range as from [date phrase] to [date phrase], examples:
+// between monday and friday
+// between 9am and 5pm
+// between 1 hour and 30 minutes before standup and standup
+func (st *Humantime) Between(input string) (*TimeRange, error) {
+	if !strings.HasPrefix(input, "between ") {
+		return nil, fmt.Errorf("input does not start with 'between': %s", input)
+	}
+
+	var parts = strings.Split(strings.TrimPrefix(input, "between "), " and ")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("input must contain ' and ': %s", input)
+	}
+
+	// date phrases can contain "and" too, the first split where both ends parse wins
+	var firstErr error
+	for i := 1; i < len(parts); i++ {
+		var tr, err = st.span(strings.Join(parts[:i], " and "), strings.Join(parts[i:], " and "), false)
+		if err == nil {
+			return tr, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
+
+// Span takes two date phrases joined by through, thru, a dash or "..",
+// through and thru include the whole end, examples:
+// monday through friday
+// mon thru fri
+// 9am-5pm
+// Mar 3 – Mar 5
+// 2024-03-03..2024-03-05
+func (st *Humantime) Span(input string) (*TimeRange, error) {
+	var from, to, through, found = st.splitSpan(input)
+	if !found {
+		return nil, fmt.Errorf("input must contain 'through', 'thru', '-' or '..': %s", input)
+	}
+	return st.span(from, to, through)
+}
+
+// splitSpan finds the separator between the ends of a span. A dash between
+// spaces followed by a duration is arithmetic like "now - 2 hours", not a span.
+func (ht *Humantime) splitSpan(input string) (from, to string, through, found bool) {
+	if match := timeSpanRegex.FindStringSubmatch(input); match != nil {
+		return match[1], match[2], false, true
+	}
+	if loc := throughRegex.FindStringIndex(input); loc != nil {
+		return input[:loc[0]], input[loc[1]:], true, true
+	}
+
+	for _, loc := range spanDashRegex.FindAllStringIndex(input, -1) {
+		var term = input[loc[1]:]
+		if next := arithmeticRegex.FindStringIndex(term); next != nil {
+			term = term[:next[0]]
+		}
+		if strings.TrimSpace(input[loc[0]:loc[1]]) != "-" || !ht.isDuration(term) {
+			return input[:loc[0]], input[loc[1]:], false, true
+		}
+	}
+	return "", "", false, false
+}
+
+// isDuration reports whether the input is a duration like "1 hour and 30 minutes"
+func (ht *Humantime) isDuration(input string) bool {
+	var _, ok = ht.vocab.load().parseLength(input)
+	return ok
+}
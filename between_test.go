@@ -0,0 +1,89 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+
+	var cases = map[string]struct {
+		fromTo   string
+		expected TimeRange
+	}{
+		"between monday and tuesday": {"from monday to tuesday", TimeRange{From: march(4, 0), To: march(5, 0)}},
+		"between 9am and 5pm":        {"from 9am to 5pm", TimeRange{From: march(6, 9), To: march(6, 17)}},
+		"BETWEEN Mar 3 AND Mar 5":    {"from mar 3 to mar 5", TimeRange{From: march(3, 0), To: march(5, 0)}},
+		"between 1 hour and 30 minutes before 3pm and 5pm": {
+			"from 1 hour and 30 minutes before 3pm to 5pm",
+			TimeRange{From: march(6, 13).Add(30 * time.Minute), To: march(6, 17)},
+		},
+	}
+	for input, c := range cases {
+		result, err := st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, c.expected, *result, input)
+
+		fromTo, err := st.Parse(c.fromTo)
+		assert.NoError(t, err, c.fromTo)
+		assert.Equal(t, *fromTo, *result, input)
+	}
+
+	result, err := st.Between("from monday to friday")
+	assert.Equal(t, "input does not start with 'between': from monday to friday", err.Error())
+	assert.Nil(t, result)
+
+	result, err = st.Between("between monday")
+	assert.Equal(t, "input must contain ' and ': between monday", err.Error())
+	assert.Nil(t, result)
+
+	result, err = st.Between("between monday and someday")
+	assert.Equal(t, "error parsingDatePhrase: could not parse someday", err.Error())
+	assert.Nil(t, result)
+}
+
+func TestSpan(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+
+	var cases = map[string]TimeRange{
+		"this monday through this friday":  {From: march(4, 0), To: march(9, 0)},
+		"mon thru tue":                     {From: march(4, 0), To: march(6, 0)},
+		"9am-5pm":                          {From: march(6, 9), To: march(6, 17)},
+		"9am - 5pm":                        {From: march(6, 9), To: march(6, 17)},
+		"9:30 - 17:00":                     {From: march(6, 9).Add(30 * time.Minute), To: march(6, 17)},
+		"9am thru 5pm":                     {From: march(6, 9), To: march(6, 17), ToInclusive: true},
+		"Mar 3 – Mar 5":                    {From: march(3, 0), To: march(5, 0)},
+		"Mar 3—Mar 5":                      {From: march(3, 0), To: march(5, 0)},
+		"2024-03-03..2024-03-05":           {From: march(3, 0), To: march(5, 0)},
+		"2024-03-03 .. 2024-03-05":         {From: march(3, 0), To: march(5, 0)},
+		"2024-03-03 through 2024-03-05":    {From: march(3, 0), To: march(6, 0)},
+		"last month through this month":    {From: february(1), To: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		"yesterday at 3pm - tomorrow":      {From: march(5, 15), To: march(7, 0)},
+		"now - 2 hours thru now + 2 hours": {From: march(6, 8).Add(30 * time.Minute), To: march(6, 12).Add(30 * time.Minute), ToInclusive: true},
+	}
+	for input, expected := range cases {
+		result, err := st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+
+	// arithmetic is not a span
+	_, err := st.Parse("now - 2 hours")
+	assert.Equal(t, "unsupported format: now - 2 hours", err.Error())
+	_, err = st.Parse("now - 2 hours - 1 day")
+	assert.Equal(t, "unsupported format: now - 2 hours - 1 day", err.Error())
+
+	result, err := st.Span("monday")
+	assert.Equal(t, "input must contain 'through', 'thru', '-' or '..': monday", err.Error())
+	assert.Nil(t, result)
+
+	_, err = st.Parse("monday thru someday")
+	assert.Equal(t, "error parsingDatePhrase: could not parse someday", err.Error())
+}
@@ -0,0 +1,27 @@
+// Package dateparseadapter lets humantime read absolute dates with
+// github.com/araddon/dateparse. It lives in its own module so humantime
+// itself does not depend on dateparse.
+//
+//	var st, err = humantime.New(humantime.WithAbsoluteParser(dateparseadapter.Parser{PreferMonthFirst: true}))
+package dateparseadapter
+
+import (
+	"time"
+
+	"github.com/araddon/dateparse"
+)
+
+// Parser fulfills the humantime.AbsoluteParser interface using dateparse
+type Parser struct {
+	// PreferMonthFirst reads ambiguous numeric dates like 3/4/2022 as month first
+	PreferMonthFirst bool
+
+	// RetryAmbiguousDateWithSwap swaps the day and month when the preferred
+	// order does not produce a valid date
+	RetryAmbiguousDateWithSwap bool
+}
+
+// ParseAbsolute fulfills the humantime.AbsoluteParser interface
+func (p Parser) ParseAbsolute(input string, loc *time.Location) (time.Time, error) {
+	return dateparse.ParseIn(input, loc, dateparse.PreferMonthFirst(p.PreferMonthFirst), dateparse.RetryAmbiguousDateWithSwap(p.RetryAmbiguousDateWithSwap))
+}
@@ -0,0 +1,31 @@
+package dateparseadapter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kmulvey/humantime"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ humantime.AbsoluteParser = Parser{}
+
+func TestParser(t *testing.T) {
+	t.Parallel()
+
+	var st, err = humantime.New(humantime.WithLocation(time.UTC), humantime.WithAbsoluteParser(Parser{PreferMonthFirst: true}))
+	assert.NoError(t, err)
+
+	result, err := st.Parse("since May 8, 2009 5:57:51 PM")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2009, time.May, 8, 17, 57, 51, 0, time.UTC), result.From)
+
+	// not in humantime.DefaultLayouts
+	result, err = st.Parse("from 12 Feb 2006, 19:17 to tomorrow")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2006, time.February, 12, 19, 17, 0, 0, time.UTC), result.From)
+
+	date, err := Parser{}.ParseAbsolute("nope", time.UTC)
+	assert.Error(t, err)
+	assert.Equal(t, time.Time{}, date)
+}
@@ -0,0 +1,151 @@
+package humantime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a calendar aware length of time: years, months and days are
+// added with time.AddDate so they respect month lengths and daylight saving
+// time, Clock is added afterwards as is. "1 month" after January 31st is
+// March 2nd (or 3rd), like time.AddDate.
+type Duration struct {
+	Years  int
+	Months int
+	Days   int
+	Clock  time.Duration
+}
+
+// compactUnits are the units of compact durations like "2h30m"
+var compactUnits = map[string]Duration{
+	"y":  {Years: 1},
+	"mo": {Months: 1},
+	"w":  {Days: 7},
+	"d":  {Days: 1},
+	"h":  {Clock: time.Hour},
+	"m":  {Clock: time.Minute},
+	"s":  {Clock: time.Second},
+	"ms": {Clock: time.Millisecond},
+	"us": {Clock: time.Microsecond},
+	"µs": {Clock: time.Microsecond},
+	"ns": {Clock: time.Nanosecond},
+}
+
+// ParseDuration parses a compact duration like "3d", "2h30m", "1w2d" or
+// "-15m". The units are y, mo, w, d, h, m, s, ms, us (or µs) and ns, a week
+// is 7 days and only hours and shorter can have a fraction like "1.5h".
+// A leading '-' negates every part.
+func ParseDuration(input string) (Duration, error) {
+	var match = compactRegex.FindStringSubmatch(strings.Join(strings.Fields(strings.ToLower(input)), ""))
+	if match == nil {
+		return Duration{}, fmt.Errorf("invalid duration: %s", input)
+	}
+	var d, err = parseCompact(match[2])
+	if err != nil {
+		return Duration{}, fmt.Errorf("invalid duration: %s, err: %w", input, err)
+	}
+	if match[1] == "-" {
+		return d.Neg(), nil
+	}
+	return d, nil
+}
+
+// compactLength reads a compact duration without a sign like "2h 30m", ok is
+// false when the input is not one
+func compactLength(input string) (Duration, bool) {
+	var compact = strings.Join(strings.Fields(input), "")
+	var match = compactRegex.FindStringSubmatch(compact)
+	if match == nil || match[1] != "" {
+		return Duration{}, false
+	}
+	var d, err = parseCompact(compact)
+	return d, err == nil
+}
+
+// parseCompact adds up the parts of a compact duration without a sign
+func parseCompact(input string) (Duration, error) {
+	var total Duration
+	for _, part := range compactPartRegex.FindAllStringSubmatch(input, -1) {
+		var unit = compactUnits[part[2]]
+		if unit.Clock != 0 {
+			var clock, err = time.ParseDuration(part[1] + part[2])
+			if err != nil {
+				return Duration{}, err
+			}
+			total.Clock += clock
+			continue
+		}
+		var n, err = strconv.Atoi(part[1])
+		if err != nil {
+			return Duration{}, fmt.Errorf("%s cannot have a fraction", part[0])
+		}
+		total = total.Add(unit.times(n))
+	}
+	return total, nil
+}
+
+// times multiplies every part of the duration by n
+func (d Duration) times(n int) Duration {
+	return Duration{Years: d.Years * n, Months: d.Months * n, Days: d.Days * n, Clock: d.Clock * time.Duration(n)}
+}
+
+// Add sums two durations part by part
+func (d Duration) Add(other Duration) Duration {
+	return Duration{Years: d.Years + other.Years, Months: d.Months + other.Months, Days: d.Days + other.Days, Clock: d.Clock + other.Clock}
+}
+
+// Sub subtracts other from d part by part
+func (d Duration) Sub(other Duration) Duration {
+	return d.Add(other.Neg())
+}
+
+// Neg returns the duration with every part negated
+func (d Duration) Neg() Duration {
+	return d.times(-1)
+}
+
+// IsZero reports whether every part of the duration is zero
+func (d Duration) IsZero() bool {
+	return d == Duration{}
+}
+
+// AddTo adds the duration to t, the calendar parts first
+func (d Duration) AddTo(t time.Time) time.Time {
+	return t.AddDate(d.Years, d.Months, d.Days).Add(d.Clock)
+}
+
+// SubFrom subtracts the duration from t, the calendar parts first
+func (d Duration) SubFrom(t time.Time) time.Time {
+	return t.AddDate(-d.Years, -d.Months, -d.Days).Add(-d.Clock)
+}
+
+// String returns the duration in words, e.g. "1 month 2 days 3 hours".
+// Clocks with fractions of a second are written like time.Duration.
+func (d Duration) String() string {
+	var parts []string
+	var add = func(n int, name string) {
+		if n == 1 || n == -1 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, name))
+		} else if n != 0 {
+			parts = append(parts, fmt.Sprintf("%d %ss", n, name))
+		}
+	}
+	add(d.Years, "year")
+	add(d.Months, "month")
+	add(d.Days, "day")
+
+	if d.Clock%time.Second != 0 {
+		parts = append(parts, d.Clock.String())
+	} else {
+		add(int(d.Clock/time.Hour), "hour")
+		add(int(d.Clock%time.Hour/time.Minute), "minute")
+		add(int(d.Clock%time.Minute/time.Second), "second")
+	}
+
+	if len(parts) == 0 {
+		return "0 seconds"
+	}
+	return strings.Join(parts, " ")
+}
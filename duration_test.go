@@ -0,0 +1,67 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+
+	var d = Duration{Years: 1, Months: 2, Days: 3, Clock: 4 * time.Hour}
+	assert.Equal(t, Duration{Years: 2, Months: 4, Days: 6, Clock: 8 * time.Hour}, d.Add(d))
+	assert.True(t, d.Sub(d).IsZero())
+	assert.Equal(t, Duration{Years: -1, Months: -2, Days: -3, Clock: -4 * time.Hour}, d.Neg())
+
+	// calendar parts first, like time.AddDate
+	var jan31 = time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC), Duration{Months: 1}.AddTo(jan31))
+	assert.Equal(t, time.Date(2023, time.December, 31, 11, 0, 0, 0, time.UTC), Duration{Months: 1, Clock: time.Hour}.SubFrom(jan31))
+
+	var cases = map[string]Duration{
+		"0 seconds":                         {},
+		"1 year 2 months 3 days 4 hours":    d,
+		"1 day 1 hour 30 minutes 5 seconds": {Days: 1, Clock: 90*time.Minute + 5*time.Second},
+		"-2 days -1 hour":                   {Days: -2, Clock: -time.Hour},
+		"1 month 1.5s":                      {Months: 1, Clock: 1500 * time.Millisecond},
+	}
+	for expected, input := range cases {
+		assert.Equal(t, expected, input.String())
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	var cases = map[string]Duration{
+		"3d":       {Days: 3},
+		"2h30m":    {Clock: 2*time.Hour + 30*time.Minute},
+		"1w2d":     {Days: 9},
+		"-15m":     {Clock: -15 * time.Minute},
+		"+1w":      {Days: 7},
+		"1y6mo":    {Years: 1, Months: 6},
+		"1.5h":     {Clock: 90 * time.Minute},
+		"250ms":    {Clock: 250 * time.Millisecond},
+		"10us":     {Clock: 10 * time.Microsecond},
+		"10µs":     {Clock: 10 * time.Microsecond},
+		"5ns":      {Clock: 5},
+		"1d 12h":   {Days: 1, Clock: 12 * time.Hour},
+		"-1y2d3s":  {Years: -1, Days: -2, Clock: -3 * time.Second},
+		"1M":       {Clock: time.Minute},
+		"1mo1m1ms": {Months: 1, Clock: time.Minute + time.Millisecond},
+	}
+	for input, expected := range cases {
+		var result, err = ParseDuration(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	for _, input := range []string{"", "3", "d", "3x", "3 days", "--3d", "3d-2h"} {
+		var _, err = ParseDuration(input)
+		assert.Equal(t, "invalid duration: "+input, err.Error(), input)
+	}
+	var _, err = ParseDuration("1.5d")
+	assert.Equal(t, "invalid duration: 1.5d, err: 1.5d cannot have a fraction", err.Error())
+}
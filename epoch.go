@@ -0,0 +1,87 @@
+package humantime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// epochUnits are the suffixes of epoch times and the length of their unit
+var epochUnits = map[string]time.Duration{
+	"s":  time.Second,
+	"ms": time.Millisecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond,
+	"ns": time.Nanosecond,
+}
+
+// epochDigits is the unit of bare epoch times by their number of digits
+var epochDigits = map[int]time.Duration{
+	10: time.Second,
+	13: time.Millisecond,
+	16: time.Microsecond,
+	19: time.Nanosecond,
+}
+
+// minEpochDigits is how long a number with a unit but without '@' must be to be
+// an epoch, so compact durations like "30s" and "500ms" are not read as 1970
+const minEpochDigits = 10
+
+// bareEpochUnits is the unit of bare epoch times for each fixed EpochUnit
+var bareEpochUnits = map[EpochUnit]time.Duration{
+	EpochSeconds:      time.Second,
+	EpochMilliseconds: time.Millisecond,
+	EpochMicroseconds: time.Microsecond,
+	EpochNanoseconds:  time.Nanosecond,
+}
+
+// parseEpoch reads Unix epoch times: "@1700000000" is seconds,
+// "1700000000123ms" has a unit and bare numbers like 1700000000 or
+// 1700000000.5 are read according to bareEpoch, by the digits before the
+// point. found is false when the input is not an epoch.
+func (ht *Humantime) parseEpoch(input string) (date time.Time, found bool, err error) {
+	var match = epochRegex.FindStringSubmatch(strings.ToLower(input))
+	if match == nil {
+		return time.Time{}, false, nil
+	}
+
+	var unit time.Duration
+	switch {
+	case match[4] != "" && match[1] == "" && len(match[2]) < minEpochDigits:
+		return time.Time{}, false, nil
+	case match[4] != "":
+		unit = epochUnits[match[4]]
+	case match[1] != "":
+		unit = time.Second
+	case ht.bareEpoch == EpochByDigits:
+		if unit, found = epochDigits[len(match[2])]; !found {
+			return time.Time{}, false, nil
+		}
+	case ht.bareEpoch == EpochNone:
+		return time.Time{}, false, nil
+	default:
+		unit = bareEpochUnits[ht.bareEpoch]
+	}
+
+	whole, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return time.Time{}, true, fmt.Errorf("invalid epoch: %s, err: %w", input, err)
+	}
+
+	// split into seconds and nanoseconds so large values do not overflow
+	var perSecond = int64(time.Second / unit)
+	var nanos = whole % perSecond * int64(unit)
+	if fraction := match[3]; fraction != "" {
+		if len(fraction) > 9 {
+			fraction = fraction[:9]
+		}
+		var digits, _ = strconv.ParseInt(fraction, 10, 64)
+		var scale = int64(1)
+		for range len(fraction) {
+			scale *= 10
+		}
+		nanos += digits * int64(unit) / scale
+	}
+	return time.Unix(whole/perSecond, nanos).In(ht.location), true, nil
+}
@@ -0,0 +1,122 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEpoch(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var epoch = time.Unix(1700000000, 0).UTC() // Tue, 14 Nov 2023 22:13:20 UTC
+	var cases = map[string]time.Time{
+		"@1700000000":           epoch,
+		"@1700000000.5":         epoch.Add(500 * time.Millisecond),
+		"1700000000.25":         epoch.Add(250 * time.Millisecond),
+		"1700000000s":           epoch,
+		"1700000000123ms":       epoch.Add(123 * time.Millisecond),
+		"1700000000123.5ms":     epoch.Add(123*time.Millisecond + 500*time.Microsecond),
+		"1700000000123456us":    epoch.Add(123456 * time.Microsecond),
+		"1700000000123456µs":    epoch.Add(123456 * time.Microsecond),
+		"1700000000123456789ns": epoch.Add(123456789 * time.Nanosecond),
+		"@0":                    time.Unix(0, 0).UTC(),
+		// bare numbers by their number of digits
+		"1700000000":          epoch,
+		"1700000000123":       epoch.Add(123 * time.Millisecond),
+		"1700000000123456":    epoch.Add(123456 * time.Microsecond),
+		"1700000000123456789": epoch.Add(123456789 * time.Nanosecond),
+		// anywhere a date phrase is
+		"2 hours after @1700000000": epoch.Add(2 * time.Hour),
+		"@1700000000 + 1 day":       epoch.AddDate(0, 0, 1),
+	}
+	for input, expected := range cases {
+		result, err := st.ParseTime(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	var ranges = map[string]TimeRange{
+		"since @1700000000":              {From: epoch, To: now},
+		"after 1700000000123ms":          {From: epoch.Add(123 * time.Millisecond), ToUnbounded: true, FromExclusive: true},
+		"before 1700000000.5":            {To: epoch.Add(500 * time.Millisecond), FromUnbounded: true},
+		"from 1700000000 to @1700003600": {From: epoch, To: epoch.Add(time.Hour)},
+	}
+	for input, expected := range ranges {
+		result, err := st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+
+	// results are in the location of the Humantime
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	inTokyo, err := New(WithLocation(tokyo))
+	assert.NoError(t, err)
+	result, err := inTokyo.ParseTime("@1700000000")
+	assert.NoError(t, err)
+	assert.Equal(t, tokyo, result.Location())
+	assert.True(t, epoch.Equal(result))
+
+	// other bare numbers are not epochs
+	_, err = st.ParseTime("17000000001")
+	assert.Error(t, err)
+	_, err = st.ParseTime("@99999999999999999999")
+	assert.Contains(t, err.Error(), "invalid epoch: @99999999999999999999")
+
+	// short numbers with a unit are durations, not epochs
+	var durations = map[string]string{
+		"30s":       "unsupported format: 30s",
+		"500ms":     "unsupported format: 500ms",
+		"since 30s": "could not parse 30s",
+	}
+	for input, expected := range durations {
+		_, err := st.Parse(input)
+		assert.EqualError(t, err, expected, input)
+	}
+	result, err = st.ParseTime("@30s")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(30, 0).UTC(), result)
+}
+
+func TestWithBareEpoch(t *testing.T) {
+	t.Parallel()
+
+	var epoch = time.Unix(1700000000, 0).UTC()
+	var cases = map[EpochUnit]time.Time{
+		EpochSeconds:      epoch,
+		EpochMilliseconds: time.UnixMilli(1700000000).UTC(),
+		EpochMicroseconds: time.UnixMicro(1700000000).UTC(),
+		EpochNanoseconds:  time.Unix(0, 1700000000).UTC(),
+	}
+	for unit, expected := range cases {
+		var st, err = New(WithLocation(time.UTC), WithBareEpoch(unit))
+		assert.NoError(t, err)
+		result, err := st.ParseTime("1700000000")
+		assert.NoError(t, err, unit)
+		assert.Equal(t, expected, result, unit)
+
+		// so are fractions
+		result, err = st.ParseTime("1700000000.5")
+		assert.NoError(t, err, unit)
+		assert.Equal(t, expected.Add(bareEpochUnits[unit]/2), result, unit)
+
+		// explicit units win
+		result, err = st.ParseTime("@1700000000")
+		assert.NoError(t, err, unit)
+		assert.Equal(t, epoch, result, unit)
+	}
+
+	var st, err = New(WithLocation(time.UTC), WithBareEpoch(EpochNone))
+	assert.NoError(t, err)
+	_, err = st.ParseTime("1700000000")
+	assert.Error(t, err)
+	_, err = st.ParseTime("1700000000.5")
+	assert.Error(t, err)
+	result, err := st.ParseTime("1700000000s")
+	assert.NoError(t, err)
+	assert.Equal(t, epoch, result)
+}
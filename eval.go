@@ -0,0 +1,94 @@
+package humantime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Value is the result of Eval, an instant or a Duration when IsDuration is true
+type Value struct {
+	Time       time.Time
+	Duration   Duration
+	IsDuration bool
+}
+
+// String returns the duration in words or the time
+func (v Value) String() string {
+	if v.IsDuration {
+		return v.Duration.String()
+	}
+	return v.Time.String()
+}
+
+// Eval evaluates expressions like "now + 3 days - 2 hours", "friday 5pm - 90 minutes"
+// or "2024-03-01T00:00Z + 1 month". Terms are date phrases or durations joined
+// by + and - with spaces around them and are evaluated left to right with
+// calendar aware arithmetic. Subtracting two instants gives the Duration between
+// them as a Clock, e.g. "tomorrow - now".
+func (st *Humantime) Eval(expr string) (Value, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return Value{}, errors.New("expression cannot be empty")
+	}
+
+	var ops = arithmeticRegex.FindAllStringSubmatchIndex(expr, -1)
+	var end = len(expr)
+	if len(ops) > 0 {
+		end = ops[0][0]
+	}
+	var result, err = st.evalTerm(expr[:end])
+	if err != nil {
+		return Value{}, err
+	}
+
+	for i, op := range ops {
+		end = len(expr)
+		if i+1 < len(ops) {
+			end = ops[i+1][0]
+		}
+		term, err := st.evalTerm(expr[op[1]:end])
+		if err != nil {
+			return Value{}, err
+		}
+		if result, err = apply(result, expr[op[2]:op[3]], term); err != nil {
+			return Value{}, fmt.Errorf("%w in expression: %s", err, expr)
+		}
+	}
+	return result, nil
+}
+
+// evalTerm reads a duration like "90 minutes" or else a date phrase
+func (ht *Humantime) evalTerm(term string) (Value, error) {
+	if d, ok := ht.vocab.load().parseLength(strings.ToLower(term)); ok {
+		return Value{Duration: d, IsDuration: true}, nil
+	}
+
+	var date, err = ht.parseDatePhrase(term)
+	if err != nil {
+		return Value{}, fmt.Errorf("error parsing term %s, err: %w", strings.TrimSpace(term), err)
+	}
+	return Value{Time: date}, nil
+}
+
+// apply adds or subtracts right from left
+func apply(left Value, op string, right Value) (Value, error) {
+	switch {
+	case left.IsDuration && right.IsDuration && op == "+":
+		return Value{Duration: left.Duration.Add(right.Duration), IsDuration: true}, nil
+	case left.IsDuration && right.IsDuration:
+		return Value{Duration: left.Duration.Sub(right.Duration), IsDuration: true}, nil
+	case !left.IsDuration && right.IsDuration && op == "+":
+		return Value{Time: right.Duration.AddTo(left.Time)}, nil
+	case !left.IsDuration && right.IsDuration:
+		return Value{Time: right.Duration.SubFrom(left.Time)}, nil
+	case left.IsDuration && op == "+":
+		return Value{Time: left.Duration.AddTo(right.Time)}, nil
+	case left.IsDuration:
+		return Value{}, errors.New("cannot subtract an instant from a duration")
+	case op == "-":
+		return Value{Duration: Duration{Clock: left.Time.Sub(right.Time)}, IsDuration: true}, nil
+	}
+	return Value{}, errors.New("cannot add two instants")
+}
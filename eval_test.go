@@ -0,0 +1,54 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEval(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+
+	var cases = map[string]Value{
+		"now + 3 days - 2 hours":            {Time: time.Date(2024, time.March, 9, 8, 30, 0, 0, time.UTC)},
+		"friday 5pm - 90 minutes":           {Time: time.Date(2024, time.March, 1, 15, 30, 0, 0, time.UTC)},
+		"2024-03-01T00:00Z + 1 month":       {Time: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		"2024-01-31 + 1 month":              {Time: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC)},
+		"2 hours + yesterday":               {Time: time.Date(2024, time.March, 5, 2, 0, 0, 0, time.UTC)},
+		"1 day + 2 hours - 30 minutes":      {Duration: Duration{Days: 1, Clock: 90 * time.Minute}, IsDuration: true},
+		"tomorrow - now":                    {Duration: Duration{Clock: 13*time.Hour + 30*time.Minute}, IsDuration: true},
+		"yesterday - tomorrow":              {Duration: Duration{Clock: -48 * time.Hour}, IsDuration: true},
+		"the day after tomorrow - 1 week":   {Time: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		"  Yesterday at 3PM + an hour  ":    {Time: time.Date(2024, time.March, 5, 16, 0, 0, 0, time.UTC)},
+		"3 days":                            {Duration: Duration{Days: 3}, IsDuration: true},
+		"2024-03-01 - 2024-02-01 + 3 hours": {Duration: Duration{Clock: 29*24*time.Hour + 3*time.Hour}, IsDuration: true},
+	}
+	for input, expected := range cases {
+		result, err := st.Eval(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	var errorCases = map[string]string{
+		"":                   "expression cannot be empty",
+		"now + tomorrow":     "cannot add two instants in expression: now + tomorrow",
+		"2 hours - now":      "cannot subtract an instant from a duration in expression: 2 hours - now",
+		"now + 3 fortnights": "error parsing term 3 fortnights, err: could not parse 3 fortnights",
+		"someday - 2 hours":  "error parsing term someday, err: could not parse someday",
+	}
+	for input, expected := range errorCases {
+		_, err := st.Eval(input)
+		assert.EqualError(t, err, expected, input)
+	}
+}
+
+func TestValueString(t *testing.T) {
+	t.Parallel()
+
+	var date = time.Date(2024, time.March, 6, 10, 30, 0, 0, time.UTC)
+	assert.Equal(t, date.String(), Value{Time: date}.String())
+	assert.Equal(t, "1 day 2 hours", Value{Duration: Duration{Days: 1, Clock: 2 * time.Hour}, IsDuration: true}.String())
+}
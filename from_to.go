@@ -2,7 +2,9 @@ package humantime
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // FromTo takes a string in the format from [date phrase] to [date phrase]
@@ -10,8 +12,6 @@ import (
 // from yesterday to today
 // from May 8, 2009 5:57:51 PM to Sep 12, 2021 3:21:22 PM
 func (st *Humantime) FromTo(input string) (*TimeRange, error) {
-	var tr = new(TimeRange)
-
 	if !strings.HasPrefix(input, "from ") {
 		return nil, fmt.Errorf("first arg must be 'from': %s", input)
 	}
@@ -19,21 +19,164 @@ func (st *Humantime) FromTo(input string) (*TimeRange, error) {
 		return nil, fmt.Errorf("input must contain 'to': %s", input)
 	}
 
-	var fromDateStr, toDateStr, found = strings.Cut(strings.ReplaceAll(input, "from ", ""), " to ")
+	var fromDateStr, toDateStr, found = strings.Cut(strings.TrimPrefix(input, "from "), " to ")
 	if !found {
 		return nil, fmt.Errorf("input must contain ' to ': %s", input)
 	}
 
+	return st.span(fromDateStr, toDateStr, false)
+}
+
+// span parses both ends of a range, through includes the whole period of the
+// end like "monday through friday" does. An end missing a part borrows it from
+// the other end: "from 3pm to 5pm yesterday" is all yesterday, "from 9 to
+// 11am" is all morning and "from Mar 3 to 5" is all March. An end that lands
+// before the start rolls forward, "from 10pm to 2am" ends on the next day.
+func (ht *Humantime) span(from, to string, through bool) (*TimeRange, error) {
+	var best *TimeRange
+	var firstErr error
+	for _, ends := range readings(strings.TrimSpace(from), strings.TrimSpace(to)) {
+		var tr, rolled, err = ht.spanEnds(ends[0], ends[1], through)
+		switch {
+		case err != nil:
+			if firstErr == nil {
+				firstErr = err
+			}
+		case !rolled:
+			return tr, nil
+		case best == nil || tr.To.Sub(tr.From) < best.To.Sub(best.From):
+			best = tr
+		}
+	}
+	if best == nil {
+		return nil, firstErr
+	}
+	return best, nil
+}
+
+// readings lists the ways to read the ends of a range, in order of preference.
+// A bare number or time borrows the am/pm of the other end, "from 9 to 11am"
+// is tried as 9am then 9pm, and is an hour next to a time like "from 9 to 17:00".
+func readings(from, to string) [][2]string {
+	var fromMatch, toMatch = amOrPmRegex.FindStringSubmatch(from), amOrPmRegex.FindStringSubmatch(to)
+	switch {
+	case bareClockRegex.MatchString(from) && toMatch != nil:
+		return [][2]string{{from + toMatch[4], to}, {from + otherMeridiem[toMatch[4]], to}}
+	case bareClockRegex.MatchString(to) && fromMatch != nil:
+		return [][2]string{{from, to + fromMatch[4]}, {from, to + otherMeridiem[fromMatch[4]]}}
+	case bareClockRegex.MatchString(from) && !strings.Contains(from, ":") && isTimeOfDay(to):
+		return [][2]string{{from + ":00", to}}
+	case bareClockRegex.MatchString(to) && !strings.Contains(to, ":") && isTimeOfDay(from):
+		return [][2]string{{from, to + ":00"}}
+	}
+	return [][2]string{{from, to}}
+}
+
+var otherMeridiem = map[string]string{"am": "pm", "pm": "am"}
+
+// isTimeOfDay reports whether the input is only a time like "3pm" or "at 17:30"
+func isTimeOfDay(input string) bool {
+	return input != "" && atTimeRegex.FindString(input) == input
+}
+
+// isDayOfMonth reports whether the input is only a day of the month like "5"
+func isDayOfMonth(input string) bool {
+	return bareClockRegex.MatchString(input) && !strings.Contains(input, ":")
+}
+
+// spanEnds parses one reading of the ends of a range, rolled is set when the
+// end had to be moved forward to come after the start
+func (ht *Humantime) spanEnds(from, to string, through bool) (*TimeRange, bool, error) {
+	var tr = new(TimeRange)
+
 	var err error
-	tr.From, err = st.parseDatePhrase(fromDateStr)
+	tr.From, _, err = ht.spanEnd(from, to)
+	if err != nil {
+		return nil, false, fmt.Errorf("error parsingDatePhrase: %w", err)
+	}
+	date, precision, err := ht.spanEnd(to, from)
 	if err != nil {
-		return nil, fmt.Errorf("error parsingDatePhrase: %w", err)
+		return nil, false, fmt.Errorf("error parsingDatePhrase: %w", err)
 	}
 
-	tr.To, err = st.parseDatePhrase(toDateStr)
+	// an end without a zone is in the zone of the other, like the 17:00 of
+	// "from 2024-03-05T15:00-05:00 to 2024-03-05 17:00"
+	if loc := tr.From.Location(); loc != ht.location && date.Location() == ht.location {
+		var zoned = *ht
+		zoned.location = loc
+		if date, precision, err = zoned.spanEnd(to, from); err != nil {
+			return nil, false, fmt.Errorf("error parsingDatePhrase: %w", err)
+		}
+	} else if loc := date.Location(); loc != ht.location && tr.From.Location() == ht.location {
+		var zoned = *ht
+		zoned.location = loc
+		if tr.From, _, err = zoned.spanEnd(from, to); err != nil {
+			return nil, false, fmt.Errorf("error parsingDatePhrase: %w", err)
+		}
+	}
+
+	var rolled bool
+	if date.Before(tr.From) {
+		if roll := ht.repeats(to); !roll.IsZero() {
+			date, rolled = roll.AddTo(date), true
+		}
+	}
+
+	tr.To = date
+	if through && precision < PrecisionDay {
+		tr.ToInclusive = true
+	} else if through {
+		tr.To = ht.periodEnd(date, precision)
+	}
+
+	return tr, rolled, nil
+}
+
+// spanEnd parses one end of a range. A time of day is on the day of the other
+// end and a day of the month in the month of the other end.
+func (ht *Humantime) spanEnd(end, other string) (time.Time, Precision, error) {
+	var timeOnly, dayOnly = isTimeOfDay(end), isDayOfMonth(end)
+	if !timeOnly && !dayOnly || isTimeOfDay(other) || isDayOfMonth(other) {
+		return ht.parsePhrase(end)
+	}
+
+	var date, _, err = ht.parsePhrase(other)
+	if err != nil { // the other end reports its own error
+		return ht.parsePhrase(end)
+	}
+
+	if dayOnly {
+		var day, _ = strconv.Atoi(end)
+		var result = time.Date(date.Year(), date.Month(), day, 0, 0, 0, 0, date.Location())
+		if result.Day() != day {
+			return time.Time{}, 0, fmt.Errorf("invalid day of the month %s for %s", end, date.Month())
+		}
+		return result, PrecisionDay, nil
+	}
+
+	var day = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	result, err := ht.parseTimeString(day, end)
 	if err != nil {
-		return nil, fmt.Errorf("error parsingDatePhrase: %w", err)
+		return time.Time{}, 0, err
 	}
+	return result, clockPrecision(end), nil
+}
 
-	return tr, nil
+// repeats is how often the end of a range comes around again: a time of day
+// every day, a weekday every week, a day of the month every month and a date
+// without a year every year. Other ends do not repeat and are zero.
+func (ht *Humantime) repeats(end string) Duration {
+	if isTimeOfDay(end) {
+		return Duration{Days: 1}
+	}
+	if isDayOfMonth(end) {
+		return Duration{Months: 1}
+	}
+	if match := ht.vocab.load().weekdayRegex.FindStringSubmatch(end); match != nil && match[0] == end && (match[1] == "" || match[1] == "on") {
+		return Duration{Days: 7}
+	}
+	if date, _, err := ht.parseLayouts(end); err == nil && date.Year() == 0 {
+		return Duration{Years: 1}
+	}
+	return Duration{}
 }
@@ -26,7 +26,7 @@ func TestFromTo(t *testing.T) {
 	t.Parallel()
 
 	var today = time.Now()
-	var st, err = NewString2Time(today.Location())
+	var st, err = New(WithLocation(today.Location()))
 	assert.NoError(t, err)
 
 	for input, expected := range TestToFromTestCases {
@@ -56,3 +56,62 @@ func TestFromTo(t *testing.T) {
 	assert.Equal(t, "error parsingDatePhrase: could not parse nope", err.Error())
 	assert.Nil(t, result)
 }
+
+func TestSharedContext(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+
+	var day = func(month time.Month, day, hour int) time.Time {
+		return time.Date(2024, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	var cases = map[string]TimeRange{
+		// shared date
+		"from 3pm to 5pm yesterday":       {From: day(time.March, 5, 15), To: day(time.March, 5, 17)},
+		"from yesterday at 3pm to 5pm":    {From: day(time.March, 5, 15), To: day(time.March, 5, 17)},
+		"between 9 and 11am yesterday":    {From: day(time.March, 5, 9), To: day(time.March, 5, 11)},
+		"from yesterday at 10pm to 2am":   {From: day(time.March, 5, 22), To: day(time.March, 6, 2)},
+		"from tuesday at 3pm to 5":        {From: day(time.March, 5, 15), To: day(time.March, 5, 17)},
+		"from 5pm yesterday to 6pm today": {From: day(time.March, 5, 17), To: day(time.March, 6, 18)},
+		// shared month and year
+		"from mar 3 to 5":  {From: day(time.March, 3, 0), To: day(time.March, 5, 0)},
+		"from 3 to mar 5":  {From: day(time.March, 3, 0), To: day(time.March, 5, 0)},
+		"from mar 30 to 2": {From: day(time.March, 30, 0), To: day(time.April, 2, 0)},
+		// shared am/pm, the other one is tried when the end would come first
+		"from 9 to 11am":  {From: day(time.March, 6, 9), To: day(time.March, 6, 11)},
+		"from 10 to 2pm":  {From: day(time.March, 6, 10), To: day(time.March, 6, 14)},
+		"from 10am to 2":  {From: day(time.March, 6, 10), To: day(time.March, 6, 14)},
+		"from 9 to 17:00": {From: day(time.March, 6, 9), To: day(time.March, 6, 17)},
+		"9-11am":          {From: day(time.March, 6, 9), To: day(time.March, 6, 11)},
+		// the end rolls forward
+		"from 10pm to 2am":      {From: day(time.March, 6, 22), To: day(time.March, 7, 2)},
+		"from friday to monday": {From: day(time.March, 1, 0), To: day(time.March, 4, 0)},
+		"monday through friday": {From: day(time.March, 4, 0), To: day(time.March, 9, 0)},
+		"from dec 30 to jan 2":  {From: time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC), To: time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		// explicit ends do not roll
+		"from 3/5/2024 to 3/1/2024": {From: day(time.March, 5, 0), To: day(time.March, 1, 0)},
+	}
+
+	for input, expected := range cases {
+		var result, err = st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+
+	_, err := st.Parse("from feb 3 to 31")
+	assert.Equal(t, "error parsingDatePhrase: invalid day of the month 31 for February", err.Error())
+
+	// shared zone
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	_, st = fixedNow(t, WithLocation(newYork))
+
+	result, err := st.Parse("from 2024-03-05T15:00:00Z to 17:00")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: day(time.March, 5, 15), To: day(time.March, 5, 17)}, *result)
+
+	result, err = st.Parse("from 2024-03-05 15:00 to 2024-03-05T17:00:00Z")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: day(time.March, 5, 15), To: day(time.March, 5, 17)}, *result)
+}
@@ -2,10 +2,7 @@ module github.com/kmulvey/humantime
 
 go 1.24.1
 
-require (
-	github.com/araddon/dateparse v0.0.0-20210429162001-6b43995a97de
-	github.com/stretchr/testify v1.9.0
-)
+require github.com/stretchr/testify v1.9.0
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -3,17 +3,21 @@ package humantime
 import (
 	"errors"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
-
-	"github.com/araddon/dateparse"
 )
 
 // String fulfills the flag.Value interface https://pkg.go.dev/flag#Value
 func (v TimeRange) String() string {
-	return fmt.Sprintf("From: %s, To: %s", v.From.Format(time.RFC1123), v.To.Format(time.RFC1123))
+	var from, to = "unbounded", "unbounded"
+	if !v.FromUnbounded {
+		from = v.From.Format(time.RFC1123)
+	}
+	if !v.ToUnbounded {
+		to = v.To.Format(time.RFC1123)
+	}
+	return fmt.Sprintf("From: %s, To: %s", from, to)
 }
 
 // Get fulfills the flag.Getter interface https://pkg.go.dev/flag#Getter
@@ -24,98 +28,193 @@ func (v *TimeRange) Get() TimeRange {
 // Set fulfills the flag.Value interface https://pkg.go.dev/flag#Value
 // must end in the format " in [timezone]" e.g. "3pm in America/New_York"
 func (v *TimeRange) Set(s string) error {
+	st, s, err := flagHumantime(s)
+	if err != nil {
+		return err
+	}
+
+	if r, err := st.Parse(s); err != nil {
+		return err
+	} else {
+		*v = *r
+	}
+	return nil
+}
+
+// flagHumantime builds the Humantime for a flag value, it is in time.Local
+// unless the value ends with " in [timezone]". The rest of the value is returned.
+func flagHumantime(s string) (*Humantime, string, error) {
 	var inputArr = strings.Fields(s)
 	var location = time.Local
 	var err error
 	if index := strings.Index(s, " in "); index > -1 {
 		location, err = time.LoadLocation(inputArr[len(inputArr)-1])
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 		s = s[:index]
 	}
 
-	st, err := NewString2Time(location)
-	if err != nil {
-		return err
-	}
-
-	if r, err := st.Parse(s); err != nil {
-		return err
-	} else {
-		v.To = r.To
-		v.From = r.From
-	}
-	return nil
+	st, err := New(WithLocation(location))
+	return st, s, err
 }
 
 // NewString2Time is just a constructor
+//
+// Deprecated: use New(WithLocation(loc))
 func NewString2Time(loc *time.Location) (*Humantime, error) {
-
-	var st = new(Humantime)
-	st.Location = loc
-
-	// init regexs
-	st.ExactTimeRegex = regexp.MustCompile(exactTime)
-	st.SynonymRegex = regexp.MustCompile(synonyms)
-	st.AtTimeRegex = regexp.MustCompile(atTime)
-	st.WeekdayRegex = regexp.MustCompile(weekdays)
-	st.AMOrPMRegex = regexp.MustCompile(amORpm)
-
-	return st, nil
+	return New(WithLocation(loc))
 }
 
+// errUnsupportedFormat is returned by Parse for input without a keyword
+var errUnsupportedFormat = errors.New("unsupported format")
+
 // Parse is the entry point for parsing English input and performs the
 // switching between different phrase types
 func (st *Humantime) Parse(input string) (*TimeRange, error) {
 
-	input = strings.ToLower(input)
+	input = strings.ToLower(strings.TrimSpace(input))
 
+	// keywords are only looked for at the ends so date phrases can contain them
+	// e.g. "since 2 days before code freeze"
+	var firstWord, _, _ = strings.Cut(input, " ")
 	switch {
-	case strings.Contains(input, "since"):
+	case firstWord == "since":
 		return st.Since(input)
-	case strings.Contains(input, "ago"):
-		return st.Ago(input)
-	case strings.Contains(input, "til"):
+	case firstWord == "until" || firstWord == "til":
 		return st.Until(input)
-	case strings.Contains(input, "before"):
+	case firstWord == "before":
 		return st.Before(input)
-	case strings.Contains(input, "after"):
+	case firstWord == "after":
 		return st.After(input)
-	case strings.Contains(input, "from") && strings.Contains(input, "to"):
+	case firstWord == "from":
 		return st.FromTo(input)
+	case firstWord == "between":
+		return st.Between(input)
+	case strings.HasSuffix(input, " ago"):
+		return st.Ago(input)
 	}
 
-	return nil, fmt.Errorf("unsupported format: %s", input)
+	if looksISO(input) {
+		return st.ISOInterval(input)
+	}
+	if period, found := toDatePeriod(input); found {
+		return st.toDate(period), nil
+	}
+	if tr, found := st.rollingWindow(input); found {
+		return tr, nil
+	}
+	if _, _, _, found := st.splitSpan(input); found {
+		return st.Span(input)
+	}
+
+	return nil, fmt.Errorf("%w: %s", errUnsupportedFormat, input)
+}
+
+// ParseTime parses a single date phrase like "tomorrow at 3pm", "3 days before
+// next friday" or "May 8, 2009 5:57:51 PM" into an instant
+func (st *Humantime) ParseTime(input string) (time.Time, error) {
+	if strings.TrimSpace(input) == "" {
+		return time.Time{}, errors.New("input cannot be empty")
+	}
+	return st.parseDatePhrase(input)
+}
+
+// now is the current time of the clock in the location of the Humantime
+func (st *Humantime) now() time.Time {
+	return st.clock().In(st.location)
+}
+
+// ParseWithPrefer is the same as Parse but overrides both the instance and the
+// keyword preference for ambiguous bare dates and times for this call only
+func (st *Humantime) ParseWithPrefer(input string, prefer Prefer) (*TimeRange, error) {
+	var ht = *st
+	ht.prefer = prefer
+	return ht.Parse(input)
+}
+
+// preferring returns a Humantime that resolves ambiguous phrases according to
+// the given keyword default, unless the caller already set a preference
+func (st *Humantime) preferring(keywordDefault Prefer) *Humantime {
+	if st.prefer != PreferDefault {
+		return st
+	}
+	var ht = *st
+	ht.prefer = keywordDefault
+	return &ht
+}
+
+// resolveTimeOfDay moves a time on the current day to the previous or next day
+// according to the preference, e.g. "3pm" at 5pm is tomorrow when preferring the future
+func (ht *Humantime) resolveTimeOfDay(now, timestamp time.Time) time.Time {
+	switch ht.prefer {
+	case PreferPast:
+		if timestamp.After(now) {
+			return timestamp.AddDate(0, 0, -1)
+		}
+	case PreferFuture:
+		if timestamp.Before(now) {
+			return timestamp.AddDate(0, 0, 1)
+		}
+	case PreferNearest:
+		return nearest(now, timestamp.AddDate(0, 0, -1), timestamp, timestamp.AddDate(0, 0, 1))
+	}
+	return timestamp
+}
+
+// nearest returns the candidate closest to now, earlier candidates win ties
+func nearest(now time.Time, candidates ...time.Time) time.Time {
+	var closest = candidates[0]
+	for _, candidate := range candidates[1:] {
+		if candidate.Sub(now).Abs() < closest.Sub(now).Abs() {
+			closest = candidate
+		}
+	}
+	return closest
 }
 
 // parseTimeString reads phrases only containing time, examples:
 // 2am
 // 7pm
+// 5:57:51 PM
 // 04:12:43 -- this format assumes 24h i.e. no a/pm
 func (st *Humantime) parseTimeString(timestamp time.Time, input string) (time.Time, error) {
 	input = strings.ReplaceAll(input, "at", "")
 	input = strings.TrimSpace(input)
 
-	if result := st.AMOrPMRegex.FindString(input); result != "" {
-		var period = result[len(result)-2:]
-		var hourNum, err = strconv.Atoi(result[:len(result)-2])
+	if match := amOrPmRegex.FindStringSubmatch(input); match != nil {
+		var hourNum, err = strconv.Atoi(match[1])
 		if err != nil {
-			return time.Time{}, fmt.Errorf("error parsing hour (%s) in: %s, err: %w", result[:len(result)-2], input, err)
+			return time.Time{}, fmt.Errorf("error parsing hour (%s) in: %s, err: %w", match[1], input, err)
+		}
+
+		var minute, second int
+		if match[2] != "" {
+			if minute, err = strconv.Atoi(match[2]); err != nil {
+				return time.Time{}, fmt.Errorf("error parsing minute in: %s, err: %w", input, err)
+			} else if minute > 59 {
+				return time.Time{}, fmt.Errorf("error parsing minute (%d) in: %s, err: minute cannot be > 59", minute, input)
+			}
+		}
+		if match[3] != "" {
+			if second, err = strconv.Atoi(match[3]); err != nil {
+				return time.Time{}, fmt.Errorf("error parsing second in: %s, err: %w", input, err)
+			} else if second > 59 {
+				return time.Time{}, fmt.Errorf("error parsing second (%d) in: %s, err: second cannot be > 59", second, input)
+			}
 		}
 
 		switch {
 		case hourNum > 12:
 			return time.Time{}, fmt.Errorf("error parsing hour (%d) in: %s, err: hour cannot be > 12", hourNum, input)
-		case result == "12am":
-			return timestamp, nil
-		case period == "am" || result == "12pm": // have to check for noon
-			return timestamp.Add(time.Duration(hourNum) * time.Hour), nil
-		default:
-			return timestamp.Add(time.Duration(hourNum+12) * time.Hour), nil
+		case hourNum == 12 && match[4] == "am": // midnight
+			hourNum = 0
+		case hourNum != 12 && match[4] == "pm": // have to check for noon
+			hourNum += 12
 		}
+		return timestamp.Add(time.Duration(hourNum) * time.Hour).Add(time.Duration(minute) * time.Minute).Add(time.Duration(second) * time.Second), nil
 
-	} else if st.ExactTimeRegex.MatchString(input) {
+	} else if exactTimeRegex.MatchString(input) {
 		var timeArr = strings.Split(input, ":")
 
 		var err error
@@ -155,60 +254,253 @@ func (st *Humantime) parseTimeString(timestamp time.Time, input string) (time.Ti
 // May 8, 2009 5:57:51 PM
 // 3/15/2022
 // next tuesday at 12am
+// on friday at 3pm
+// coming thurs
+// next month
+// 2 days before [anchor]
+// the day after tomorrow
+// a week from monday
+// $deploy + 2 hours
 func (ht *Humantime) parseDatePhrase(input string) (time.Time, error) {
+	var date, _, err = ht.parsePhrase(input)
+	return date, err
+}
 
-	if date, err := dateparse.ParseIn(input, ht.Location, dateparse.RetryAmbiguousDateWithSwap(true)); err == nil {
-		return date, nil
-	}
+// parsePhrase is parseDatePhrase that also returns how precise the phrase is
+func (ht *Humantime) parsePhrase(input string) (time.Time, Precision, error) {
 
 	var inputCopy = strings.TrimSpace(input) // so we can use the original in errors
-	var now = time.Now().In(ht.Location)
+
+	if date, found, err := ht.parseEpoch(inputCopy); found {
+		return date, PrecisionSecond, err
+	}
+	if date, precision, found, err := ht.parseSnap(inputCopy); found {
+		return date, precision, err
+	}
+	if date, precision, found, err := ht.parseOffset(inputCopy); found {
+		return date, precision, err
+	}
+	if date, precision, found, err := ht.parseArithmetic(inputCopy); found {
+		return date, precision, err
+	}
+	if date, precision, found, err := ht.parseBoundary(inputCopy); found {
+		return date, precision, err
+	}
+
+	// bare times like "00:00:01" and numeric dates like 3/4/2022 are left to us,
+	// the order of numeric dates is configured on Humantime
+	if atTimeRegex.FindString(inputCopy) != inputCopy && !numericDateRegex.MatchString(inputCopy) {
+		if date, layout, err := ht.parseAbsolute(inputCopy); err == nil {
+			if precision, found := layoutPrecisions[layout]; found {
+				return date, precision, nil
+			}
+			// a custom AbsoluteParser does not say which layout matched, a date without a time is a day
+			if !strings.Contains(inputCopy, ":") && date.Equal(ht.startOf(date, PrecisionDay)) {
+				return date, PrecisionDay, nil
+			}
+			return date, PrecisionSecond, nil
+		}
+	}
+
+	inputCopy = strings.ToLower(inputCopy) // the regexs below are all lower case
+	var vocab = ht.vocab.load()
+	var now = ht.now()
 	var nilTime = time.Time{} // used for if() testing
 	var timestamp time.Time   // this is the return val that we incrementally add to each time through the loop
+	var precision Precision   // how precise timestamp is, the last part parsed decides
+	var clock string          // the time of day, applied to timestamp after the loop
 	var i int                 // count iterations to prevent infinitely looping
 	for inputCopy != "" {
-		if result := ht.WeekdayRegex.FindString(inputCopy); result != "" {
-			var resultArr = strings.Fields(result)
-			if len(resultArr) != 2 {
-				return time.Time{}, fmt.Errorf("could not parse weekday: %s in input: %s", resultArr[1], input)
+		if result := vocab.anchorRegex.FindString(inputCopy); result != "" {
+			var anchor = vocab.anchors[strings.Join(strings.Fields(result), " ")]
+			inputCopy = strings.Replace(inputCopy, result, "", 1)
+			var err error
+			if timestamp, err = anchor(now); err != nil {
+				return time.Time{}, 0, err
 			}
-
-			var weekday, found = StringToWeekdays[resultArr[1]]
+			precision = PrecisionSecond
+		} else if match := variableRegex.FindStringSubmatch(inputCopy); match != nil {
+			var value, found = ht.vars[match[1]]
+			if !found {
+				return time.Time{}, 0, &UnboundVariableError{Name: match[1], Input: input}
+			}
+			inputCopy = strings.Replace(inputCopy, match[0], "", 1)
+			timestamp, precision = value.In(ht.location), PrecisionSecond
+		} else if match := numericDateRegex.FindStringSubmatch(inputCopy); match != nil {
+			var err error
+			timestamp, err = ht.parseNumericDate(match)
+			if err != nil {
+				return time.Time{}, 0, fmt.Errorf("%w in input: %s", err, input)
+			}
+			precision = PrecisionDay
+			inputCopy = strings.Replace(inputCopy, match[0], "", 1)
+		} else if match := periodRegex.FindStringSubmatch(inputCopy); match != nil {
+			timestamp, precision = ht.parsePeriod(now, match)
+			inputCopy = strings.Replace(inputCopy, match[0], "", 1)
+		} else if match := vocab.weekdayRegex.FindStringSubmatch(inputCopy); match != nil {
+			var weekday, found = vocab.weekdays[match[2]]
 			if !found {
-				return time.Time{}, fmt.Errorf("could not parse weekday: %s in input: %s", resultArr[1], input)
+				return time.Time{}, 0, fmt.Errorf("could not parse weekday: %s in input: %s", match[2], input)
 			}
+			precision = PrecisionDay
+
+			var today = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, ht.location)
+			var daysBack = (int(now.Weekday()) - int(weekday) + 7) % 7
+			var daysForward = (int(weekday) - int(now.Weekday()) + 7) % 7
 
-			switch resultArr[0] {
+			// days from the start of this week to the weekday
+			var thisWeek = (int(weekday)-int(ht.weekStart)+7)%7 - (int(now.Weekday())-int(ht.weekStart)+7)%7
+
+			switch match[1] {
 			case "last":
-				timestamp = time.Date(now.Year(), now.Month(), now.Day()-int(now.Weekday()-weekday)-7, 0, 0, 0, 0, ht.Location)
+				timestamp = today.AddDate(0, 0, thisWeek-7)
 			case "this":
-				timestamp = time.Date(now.Year(), now.Month(), now.Day()-int(now.Weekday()-weekday), 0, 0, 0, 0, ht.Location)
+				timestamp = today.AddDate(0, 0, thisWeek)
 			case "next":
-				timestamp = time.Date(now.Year(), now.Month(), now.Day()-int(now.Weekday()-weekday)+7, 0, 0, 0, 0, ht.Location)
-			default:
-				return time.Time{}, fmt.Errorf("could not parse weekday: %s in input: %s", resultArr[1], input)
+				timestamp = today.AddDate(0, 0, thisWeek+7)
+			case "previous", "past": // strictly before today
+				if daysBack == 0 {
+					daysBack = 7
+				}
+				timestamp = today.AddDate(0, 0, -daysBack)
+			case "coming", "upcoming": // strictly after today
+				if daysForward == 0 {
+					daysForward = 7
+				}
+				timestamp = today.AddDate(0, 0, daysForward)
+			default: // bare weekday or "on [weekday]"
+				switch ht.prefer {
+				case PreferFuture:
+					timestamp = today.AddDate(0, 0, daysForward)
+				case PreferNearest:
+					timestamp = nearest(now, today.AddDate(0, 0, -daysBack), today.AddDate(0, 0, daysForward))
+				default:
+					timestamp = today.AddDate(0, 0, -daysBack)
+				}
 			}
 
+			inputCopy = strings.Replace(inputCopy, match[0], "", 1)
+		} else if result := vocab.synonymRegex.FindString(inputCopy); result != "" {
+			var syn = vocab.synonyms[strings.Join(strings.Fields(result), " ")]
 			inputCopy = strings.Replace(inputCopy, result, "", 1)
-		} else if result := ht.SynonymRegex.FindString(inputCopy); result != "" {
-			var syn, _ = TimeSynonyms[result] // ignore second return val as how could it not be found?
-			inputCopy = strings.Replace(inputCopy, result, "", 1)
-			timestamp = syn(ht.Location)
-		} else if result := ht.AtTimeRegex.FindString(inputCopy); result != "" {
-			var err error
-			if timestamp == nilTime { // no day specified, assume today e.g. "3pm"
-				timestamp = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, ht.Location)
-			}
-			timestamp, err = ht.parseTimeString(timestamp, result)
-			if err != nil {
-				return time.Time{}, err
+			timestamp = syn(now)
+			// synonyms landing on midnight like "tomorrow" are the whole day
+			precision = PrecisionSecond
+			if timestamp.Equal(ht.startOf(timestamp, PrecisionDay)) {
+				precision = PrecisionDay
 			}
+		} else if result := atTimeRegex.FindString(inputCopy); result != "" && clock == "" {
+			// applied once the day is known, "5pm yesterday" is the same as "yesterday at 5pm"
+			clock = result
 			inputCopy = strings.Replace(inputCopy, result, "", 1)
 		} else if i == 5 { // catch all so we dont loop forever
-			return time.Time{}, fmt.Errorf("could not parse %s", input)
+			return time.Time{}, 0, fmt.Errorf("could not parse %s", input)
 		}
 		inputCopy = strings.TrimSpace(inputCopy)
 		i++
 	}
-	return timestamp, nil
+
+	if clock != "" {
+		var noDay = timestamp == nilTime
+		if noDay { // no day specified, assume today e.g. "3pm"
+			timestamp = now
+		}
+		timestamp = timestamp.In(ht.location)
+		timestamp = time.Date(timestamp.Year(), timestamp.Month(), timestamp.Day(), 0, 0, 0, 0, ht.location)
+		var err error
+		timestamp, err = ht.parseTimeString(timestamp, clock)
+		if err != nil {
+			return time.Time{}, 0, err
+		}
+		precision = clockPrecision(clock)
+		if noDay {
+			timestamp = ht.resolveTimeOfDay(now, timestamp)
+		}
+	}
+	return timestamp, precision, nil
+}
+
+// parseAbsolute tries the custom layouts and then the AbsoluteParser, dates
+// without a year like "Mar 3" are in the current year
+func (ht *Humantime) parseAbsolute(input string) (time.Time, string, error) {
+	var date, layout, err = ht.parseLayouts(input)
+	if err != nil || date.Year() != 0 {
+		return date, layout, err
+	}
+	return time.Date(ht.now().Year(), date.Month(), date.Day(), date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location()), layout, nil
+}
+
+// parseLayouts tries the custom layouts and then the AbsoluteParser, the layout
+// that matched is returned when known
+func (ht *Humantime) parseLayouts(input string) (time.Time, string, error) {
+	for _, layout := range ht.layouts {
+		if date, err := time.ParseInLocation(layout, input, ht.location); err == nil {
+			return date, layout, nil
+		}
+	}
+	if lp, ok := ht.absoluteParser.(*LayoutParser); ok {
+		return lp.parse(input, ht.location)
+	}
+	var date, err = ht.absoluteParser.ParseAbsolute(input, ht.location)
+	return date, "", err
+}
+
+// parseNumericDate turns the submatches of numericDateRegex into a date according
+// to the date order, strictness and two digit year pivot. A date that is
+// impossible in the date order but valid with the day and month swapped
+// (e.g. 15/3/2022 in MDY) is not ambiguous and is read swapped, year first
+// dates are always YMD and never swapped.
+func (ht *Humantime) parseNumericDate(match []string) (time.Time, error) {
+	var fields [3]int
+	for i, field := range match[1:4] {
+		var err error
+		if fields[i], err = strconv.Atoi(field); err != nil {
+			return time.Time{}, fmt.Errorf("error parsing date %s, err: %w", match[0], err)
+		}
+	}
+
+	var year, month, day int
+	var yearField string
+	var yearFirst = len(match[1]) > 2 || ht.dateOrder == YMD
+	switch {
+	case yearFirst:
+		year, month, day, yearField = fields[0], fields[1], fields[2], match[1]
+	case ht.dateOrder == DMY:
+		day, month, year, yearField = fields[0], fields[1], fields[2], match[3]
+	default:
+		month, day, year, yearField = fields[0], fields[1], fields[2], match[3]
+	}
+
+	switch len(yearField) {
+	case 1, 2:
+		if year < ht.twoDigitYearPivot {
+			year += 2000
+		} else {
+			year += 1900
+		}
+	case 3:
+		return time.Time{}, fmt.Errorf("could not parse year %s in date %s", yearField, match[0])
+	}
+
+	var valid = validDate(year, month, day)
+	var swappedValid = !yearFirst && month != day && validDate(year, day, month)
+	switch {
+	case valid && swappedValid && ht.strict:
+		return time.Time{}, fmt.Errorf("ambiguous date %s could be %s or %s", match[0],
+			time.Date(year, time.Month(month), day, 0, 0, 0, 0, ht.location).Format("Jan 2 2006"),
+			time.Date(year, time.Month(day), month, 0, 0, 0, 0, ht.location).Format("Jan 2 2006"))
+	case valid:
+		return time.Date(year, time.Month(month), day, 0, 0, 0, 0, ht.location), nil
+	case swappedValid:
+		return time.Date(year, time.Month(day), month, 0, 0, 0, 0, ht.location), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date %s", match[0])
+}
+
+// validDate reports whether the year, month and day exist on the calendar
+func validDate(year, month, day int) bool {
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+	return day <= time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
 }
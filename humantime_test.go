@@ -13,6 +13,18 @@ func init() {
 	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
 }
 
+// fixedNow returns the instant most tests run at, Wednesday March 6 2024 10:30
+// UTC, and a Humantime in UTC whose clock is stopped there. opts are applied
+// after the location and clock.
+func fixedNow(t *testing.T, opts ...Option) (time.Time, *Humantime) {
+	t.Helper()
+
+	var now = time.Date(2024, time.March, 6, 10, 30, 0, 0, time.UTC)
+	var st, err = New(append([]Option{WithLocation(time.UTC), WithClock(func() time.Time { return now })}, opts...)...)
+	assert.NoError(t, err)
+	return now, st
+}
+
 var TestParseTimeStringTestCases = map[string]time.Time{
 	"  at  3pm":   time.Date(today.Year(), today.Month(), today.Day(), 15, 0, 0, 0, today.Location()),
 	"    5am":     time.Date(today.Year(), today.Month(), today.Day(), 5, 0, 0, 0, today.Location()),
@@ -22,6 +34,9 @@ var TestParseTimeStringTestCases = map[string]time.Time{
 	"  00:00:00 ": time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location()),
 	"  23:59:59 ": time.Date(today.Year(), today.Month(), today.Day(), 23, 59, 59, 0, today.Location()),
 	"  3:9 ":      time.Date(today.Year(), today.Month(), today.Day(), 3, 9, 0, 0, today.Location()),
+	"5:57:51 pm":  time.Date(today.Year(), today.Month(), today.Day(), 17, 57, 51, 0, today.Location()),
+	"at 12:30 am": time.Date(today.Year(), today.Month(), today.Day(), 0, 30, 0, 0, today.Location()),
+	"9 am":        time.Date(today.Year(), today.Month(), today.Day(), 9, 0, 0, 0, today.Location()),
 }
 
 var TestParseDatePhraseTestCases = map[string]time.Time{
@@ -35,10 +50,25 @@ var TestParseDatePhraseTestCases = map[string]time.Time{
 	"this sunday   at   12:33:42": time.Date(today.Year(), today.Month(), today.Day()-int(today.Weekday()-time.Sunday), 12, 33, 42, 0, today.Location()),
 }
 
+// TestParseWeekdayTestCases are resolved against fixedNow, Wednesday March 6 2024
+var TestParseWeekdayTestCases = map[string]time.Time{
+	"friday":             time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+	"on fri at 3pm":      time.Date(2024, time.March, 1, 15, 0, 0, 0, time.UTC),
+	"wednesday":          time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC),
+	"weds":               time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC),
+	"last tues":          time.Date(2024, time.February, 27, 0, 0, 0, 0, time.UTC),
+	"next thurs at 2am":  time.Date(2024, time.March, 14, 2, 0, 0, 0, time.UTC),
+	"this sa":            time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC),
+	"previous monday":    time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+	"past su":            time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC),
+	"coming thu":         time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC),
+	"upcoming tu at 4pm": time.Date(2024, time.March, 12, 16, 0, 0, 0, time.UTC),
+}
+
 func TestParseTimeString(t *testing.T) {
 	t.Parallel()
 
-	var st, err = NewString2Time(today.Location())
+	var st, err = New(WithLocation(today.Location()))
 	assert.NoError(t, err)
 
 	for input, expected := range TestParseTimeStringTestCases {
@@ -60,6 +90,10 @@ func TestParseTimeString(t *testing.T) {
 	assert.Equal(t, "error parsing minute (73) in: 3:73:12, err: minute cannot be > 59", err.Error())
 	assert.Equal(t, time.Time{}, result)
 
+	result, err = st.parseTimeString(today, "3:61pm")
+	assert.Equal(t, "error parsing minute (61) in: 3:61pm, err: minute cannot be > 59", err.Error())
+	assert.Equal(t, time.Time{}, result)
+
 	result, err = st.parseTimeString(today, "3:3:82")
 	assert.Equal(t, "error parsing second (82) in: 3:3:82, err: second cannot be > 59", err.Error())
 	assert.Equal(t, time.Time{}, result)
@@ -68,7 +102,7 @@ func TestParseTimeString(t *testing.T) {
 func TestParseDatePhrase(t *testing.T) {
 	t.Parallel()
 
-	var st, err = NewString2Time(today.Location())
+	var st, err = New(WithLocation(today.Location()))
 	assert.NoError(t, err)
 
 	for input, expected := range TestParseDatePhraseTestCases {
@@ -83,10 +117,31 @@ func TestParseDatePhrase(t *testing.T) {
 	assert.Equal(t, time.Time{}, result)
 }
 
+func TestParseWeekday(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+
+	for input, expected := range TestParseWeekdayTestCases {
+		result, err := st.parseDatePhrase(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	_, st = fixedNow(t, WithPrefer(PreferFuture))
+	result, err := st.parseDatePhrase("mo")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), result)
+
+	result, err = st.parseDatePhrase("on saturday at 23:00")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 9, 23, 0, 0, 0, time.UTC), result)
+}
+
 func TestCLI(t *testing.T) {
 	t.Parallel()
 
-	var st, err = NewString2Time(time.UTC)
+	var st, err = New(WithLocation(time.UTC))
 	assert.NoError(t, err)
 
 	result, err := st.FromTo("from 1/1/2021 to 2/2/2022")
@@ -107,12 +162,18 @@ func TestCLI(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, time.Date(2001, time.January, 1, 0, 0, 0, 0, location), v.From.Round(time.Minute))
 	assert.Equal(t, time.Date(2002, time.February, 2, 0, 0, 0, 0, location), v.To.Round(time.Minute))
+
+	// open ends are printed and survive Set
+	err = result.Set("before 2/2/2002 in America/Denver")
+	assert.NoError(t, err)
+	assert.True(t, result.FromUnbounded)
+	assert.Equal(t, "From: unbounded, To: Sat, 02 Feb 2002 00:00:00 MST", result.String())
 }
 
 func TestParse(t *testing.T) {
 	t.Parallel()
 
-	var st, err = NewString2Time(today.Location())
+	var st, err = New(WithLocation(today.Location()))
 	assert.NoError(t, err)
 
 	result, err := st.Parse("since yesterday")
@@ -139,3 +200,154 @@ func TestParse(t *testing.T) {
 	assert.Equal(t, "unsupported format: apples", err.Error())
 	assert.Nil(t, result)
 }
+
+func TestParseTime(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var cases = map[string]time.Time{
+		"tomorrow at 3pm":           time.Date(2024, time.March, 7, 15, 0, 0, 0, time.UTC),
+		"  Next Friday  ":           time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC),
+		"3 days before next friday": time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC),
+		"May 8, 2009 5:57:51 PM":    time.Date(2009, time.May, 8, 17, 57, 51, 0, time.UTC),
+		"now":                       now,
+		"3/15/2024 at 9:30am":       time.Date(2024, time.March, 15, 9, 30, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		result, err := st.ParseTime(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	_, err := st.ParseTime(" ")
+	assert.Equal(t, "input cannot be empty", err.Error())
+
+	_, err = st.ParseTime("apples")
+	assert.Equal(t, "could not parse apples", err.Error())
+}
+
+func TestPrefer(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+
+	// keyword defaults
+	result, err := st.Parse("since friday")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), result.From)
+
+	result, err = st.Parse("until friday")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC), result.To)
+
+	result, err = st.Parse("after 23:59:59")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 5, 23, 59, 59, 0, time.UTC), result.From)
+
+	result, err = st.Parse("before 00:00:01")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 7, 0, 0, 1, 0, time.UTC), result.To)
+
+	// per call override
+	result, err = st.ParseWithPrefer("since friday", PreferFuture)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 8, 0, 0, 0, 0, time.UTC), result.From)
+
+	result, err = st.ParseWithPrefer("until 00:00:01", PreferPast)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 6, 0, 0, 1, 0, time.UTC), result.To)
+	assert.Equal(t, PreferDefault, st.prefer)
+
+	// per instance override
+	_, st = fixedNow(t, WithPrefer(PreferNearest))
+	result, err = st.Parse("until 10:29:00")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 6, 10, 29, 0, 0, time.UTC), result.To)
+
+	result, err = st.Parse("since 10:31:00")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 6, 10, 31, 0, 0, time.UTC), result.From)
+
+	// nearest crosses midnight when that is closer
+	result, err = st.Parse("since 23:00")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 5, 23, 0, 0, 0, time.UTC), result.From)
+}
+
+func TestDateOrder(t *testing.T) {
+	t.Parallel()
+
+	var st, err = New(WithLocation(time.UTC))
+	assert.NoError(t, err)
+
+	var cases = map[DateOrder]map[string]time.Time{
+		MDY: {
+			"03/04/2024":           time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+			"15/3/2022":            time.Date(2022, time.March, 15, 0, 0, 0, 0, time.UTC),
+			"3/15/22":              time.Date(2022, time.March, 15, 0, 0, 0, 0, time.UTC),
+			"3.15.70":              time.Date(1970, time.March, 15, 0, 0, 0, 0, time.UTC),
+			"2024-03-04":           time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+			"3/15/2022 5:57:51 PM": time.Date(2022, time.March, 15, 17, 57, 51, 0, time.UTC),
+		},
+		DMY: {
+			"03/04/2024":          time.Date(2024, time.April, 3, 0, 0, 0, 0, time.UTC),
+			"15/3/2022 at 3pm":    time.Date(2022, time.March, 15, 15, 0, 0, 0, time.UTC),
+			"2024-03-04 10:11:12": time.Date(2024, time.March, 4, 10, 11, 12, 0, time.UTC),
+		},
+		YMD: {
+			"24/03/04": time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for order, inputs := range cases {
+		var ht, err = New(WithLocation(time.UTC), WithDateOrder(order))
+		assert.NoError(t, err)
+		for input, expected := range inputs {
+			result, err := ht.parseDatePhrase(input)
+			assert.NoError(t, err, input)
+			assert.Equal(t, expected, result, input)
+		}
+	}
+
+	// pivot
+	ht, err := New(WithLocation(time.UTC), WithTwoDigitYearPivot(50))
+	assert.NoError(t, err)
+	result, err := ht.parseDatePhrase("3/15/49")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2049, time.March, 15, 0, 0, 0, 0, time.UTC), result)
+	result, err = ht.parseDatePhrase("3/15/50")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(1950, time.March, 15, 0, 0, 0, 0, time.UTC), result)
+
+	// strict
+	ht, err = New(WithLocation(time.UTC), WithStrict(true))
+	assert.NoError(t, err)
+	result, err = ht.parseDatePhrase("03/04/2024")
+	assert.Equal(t, "ambiguous date 03/04/2024 could be Mar 4 2024 or Apr 3 2024 in input: 03/04/2024", err.Error())
+	assert.Equal(t, time.Time{}, result)
+
+	result, err = ht.parseDatePhrase("04/04/2024")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.April, 4, 0, 0, 0, 0, time.UTC), result)
+
+	result, err = ht.parseDatePhrase("2024-03-04")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), result)
+
+	// errors
+	result, err = st.parseDatePhrase("13/13/2024")
+	assert.Equal(t, "invalid date 13/13/2024 in input: 13/13/2024", err.Error())
+	assert.Equal(t, time.Time{}, result)
+
+	result, err = st.parseDatePhrase("1/1/202")
+	assert.Equal(t, "could not parse year 202 in date 1/1/202 in input: 1/1/202", err.Error())
+	assert.Equal(t, time.Time{}, result)
+
+	// applies to keywords too
+	ht, err = New(WithLocation(time.UTC), WithDateOrder(DMY))
+	assert.NoError(t, err)
+	tr, err := ht.Parse("since 03/04/2024")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.April, 3, 0, 0, 0, 0, time.UTC), tr.From)
+}
@@ -0,0 +1,40 @@
+package humantime
+
+import (
+	"flag"
+	"time"
+)
+
+// Instant is a single point in time that can be set from a date phrase on the
+// command line, it is the flag counterpart of ParseTime
+type Instant struct {
+	time.Time
+}
+
+var _ flag.Getter = (*Instant)(nil)
+
+// String fulfills the flag.Value interface https://pkg.go.dev/flag#Value
+func (v Instant) String() string {
+	return v.Format(time.RFC1123)
+}
+
+// Get fulfills the flag.Getter interface https://pkg.go.dev/flag#Getter
+func (v *Instant) Get() any {
+	return v.Time
+}
+
+// Set fulfills the flag.Value interface https://pkg.go.dev/flag#Value
+// may end in the format " in [timezone]" e.g. "tomorrow at 3pm in America/New_York"
+func (v *Instant) Set(s string) error {
+	st, s, err := flagHumantime(s)
+	if err != nil {
+		return err
+	}
+
+	t, err := st.ParseTime(s)
+	if err != nil {
+		return err
+	}
+	v.Time = t
+	return nil
+}
@@ -0,0 +1,36 @@
+package humantime
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstant(t *testing.T) {
+	t.Parallel()
+
+	var fs = flag.NewFlagSet("test", flag.ContinueOnError)
+	var deadline Instant
+	fs.Var(&deadline, "deadline", "deadline to parse")
+
+	assert.NoError(t, fs.Parse([]string{"-deadline", "May 8, 2009 5:57:51 PM in America/Denver"}))
+	location, err := time.LoadLocation("America/Denver")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2009, time.May, 8, 17, 57, 51, 0, location), deadline.Time)
+	assert.Equal(t, "Fri, 08 May 2009 17:57:51 MDT", deadline.String())
+	assert.Equal(t, deadline.Time, fs.Lookup("deadline").Value.(flag.Getter).Get())
+
+	var v Instant
+	err = v.Set("tomorrow in America/NoExist")
+	assert.Equal(t, "unknown time zone America/NoExist", err.Error())
+
+	err = v.Set("someday")
+	assert.Equal(t, "could not parse someday", err.Error())
+	assert.True(t, v.IsZero())
+
+	assert.NoError(t, v.Set("tomorrow at 3pm in UTC"))
+	var now = time.Now().UTC()
+	assert.Equal(t, time.Date(now.Year(), now.Month(), now.Day()+1, 15, 0, 0, 0, time.UTC), v.Time)
+}
@@ -0,0 +1,264 @@
+package humantime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseISODuration parses an ISO 8601 duration like "P1Y2M3DT4H", "PT90M",
+// "P2W" or "PT1.5S". Weeks are 7 days, a leading '-' negates every part.
+func ParseISODuration(input string) (Duration, error) {
+	var upper = strings.ToUpper(strings.TrimSpace(input))
+	var match = isoDurationRegex.FindStringSubmatch(upper)
+	if match == nil || strings.HasSuffix(upper, "P") || strings.HasSuffix(upper, "T") {
+		return Duration{}, fmt.Errorf("invalid ISO 8601 duration: %s", input)
+	}
+
+	var d Duration
+	var parts = []*int{&d.Years, &d.Months, nil, &d.Days}
+	for i, part := range parts {
+		if match[i+2] == "" || part == nil {
+			continue
+		}
+		*part, _ = strconv.Atoi(match[i+2])
+	}
+	if match[4] != "" {
+		var weeks, _ = strconv.Atoi(match[4])
+		d.Days += 7 * weeks
+	}
+	if match[6] != "" {
+		var hours, _ = strconv.Atoi(match[6])
+		d.Clock += time.Duration(hours) * time.Hour
+	}
+	if match[7] != "" {
+		var minutes, _ = strconv.Atoi(match[7])
+		d.Clock += time.Duration(minutes) * time.Minute
+	}
+	if match[8] != "" {
+		var seconds, err = time.ParseDuration(strings.Replace(match[8], ",", ".", 1) + "s")
+		if err != nil {
+			return Duration{}, fmt.Errorf("invalid ISO 8601 duration: %s, err: %w", input, err)
+		}
+		d.Clock += seconds
+	}
+
+	if match[1] == "-" {
+		return d.Neg(), nil
+	}
+	return d, nil
+}
+
+// ISO returns the duration in ISO 8601 e.g. "P1Y2M3DT4H" or "PT1H30M", the
+// zero duration is "PT0S". A duration with every part negative has a leading
+// '-', mixed signs are written part by part.
+func (d Duration) ISO() string {
+	if d.IsZero() {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	if d.Years <= 0 && d.Months <= 0 && d.Days <= 0 && d.Clock <= 0 {
+		b.WriteString("-")
+		d = d.Neg()
+	}
+	b.WriteString("P")
+	for _, part := range []struct {
+		n    int
+		unit string
+	}{{d.Years, "Y"}, {d.Months, "M"}, {d.Days, "D"}} {
+		if part.n != 0 {
+			fmt.Fprintf(&b, "%d%s", part.n, part.unit)
+		}
+	}
+
+	if d.Clock != 0 {
+		b.WriteString("T")
+		if hours := d.Clock / time.Hour; hours != 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes := d.Clock % time.Hour / time.Minute; minutes != 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+		if seconds := d.Clock % time.Minute; seconds != 0 {
+			fmt.Fprintf(&b, "%sS", strconv.FormatFloat(seconds.Seconds(), 'f', -1, 64))
+		}
+	}
+	return b.String()
+}
+
+// ISO returns the range as an ISO 8601 interval of two RFC 3339 times e.g.
+// "2024-01-01T00:00:00Z/2024-02-01T00:00:00Z", an open end is "..".
+// FromExclusive and ToInclusive cannot be written and are dropped.
+func (v TimeRange) ISO() string {
+	var from, to = "..", ".."
+	if !v.FromUnbounded {
+		from = v.From.Format(time.RFC3339Nano)
+	}
+	if !v.ToUnbounded {
+		to = v.To.Format(time.RFC3339Nano)
+	}
+	return from + "/" + to
+}
+
+// looksISO reports whether the input is an ISO 8601 duration or interval
+// rather than a phrase like "3/15/2022" or "now-1h/h"
+func looksISO(input string) bool {
+	var upper = strings.ToUpper(strings.TrimSpace(input))
+	if strings.ContainsAny(upper, " \t") {
+		return false
+	}
+	var parts = strings.Split(upper, "/")
+	if len(parts) == 3 && isoRepeatRegex.MatchString(parts[0]) {
+		parts = parts[1:]
+	}
+	if len(parts) == 1 {
+		return isoDurationRegex.MatchString(parts[0])
+	}
+	if len(parts) != 2 {
+		return false
+	}
+	for _, part := range parts {
+		if part != ".." && !isoDurationRegex.MatchString(part) && !isoDateRegex.MatchString(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// maxISORepetitions is the largest count a repeating interval like "R5/..." may have
+const maxISORepetitions = 10000
+
+// isoInterval is a parsed ISO 8601 interval, repeated every step. repetitions
+// is 1 for a plain interval and -1 for one that repeats forever.
+type isoInterval struct {
+	first       TimeRange
+	step        Duration
+	repetitions int
+}
+
+// ISOInterval takes an ISO 8601 interval and returns the range it covers, examples:
+// 2024-01-01T00:00Z/2024-02-01T00:00Z
+// 2024-01-01T00:00Z/P1M
+// P7D/2024-03-01
+// PT90M, the 90 minutes up to now
+// ../2024-03-01, without a start
+// R5/2024-01-01T00:00Z/P1D, from the start of the first repetition to the end of the last,
+// at most 10000 repetitions
+// A repeating interval without a count like R/2024-01-01T00:00Z/P1D has no end,
+// use ParseRanges for each repetition.
+func (st *Humantime) ISOInterval(input string) (*TimeRange, error) {
+	var interval, err = st.parseISOInterval(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var tr = interval.first
+	switch {
+	case interval.repetitions < 0:
+		tr.To, tr.ToUnbounded = time.Time{}, true
+	case interval.repetitions > 1:
+		tr.To = interval.step.times(interval.repetitions).AddTo(tr.From)
+	}
+	return &tr, nil
+}
+
+// isoRanges returns every repetition of an ISO 8601 repeating interval
+func (st *Humantime) isoRanges(input string) ([]TimeRange, error) {
+	var interval, err = st.parseISOInterval(input)
+	if err != nil {
+		return nil, err
+	}
+	if interval.repetitions < 0 {
+		return nil, fmt.Errorf("cannot expand a repeating interval without a count: %s", input)
+	}
+
+	// every repetition is counted from the first so months do not drift
+	var ranges = make([]TimeRange, interval.repetitions)
+	for i := range ranges {
+		ranges[i] = TimeRange{
+			From: interval.step.times(i).AddTo(interval.first.From),
+			To:   interval.step.times(i + 1).AddTo(interval.first.From),
+		}
+	}
+	return ranges, nil
+}
+
+// parseISOInterval reads the repetitions and ends of an ISO 8601 interval,
+// each end is a duration, ".." or a date phrase
+func (ht *Humantime) parseISOInterval(input string) (isoInterval, error) {
+	var parts = strings.Split(strings.TrimSpace(input), "/")
+	var interval = isoInterval{repetitions: 1}
+
+	if len(parts) == 3 {
+		var match = isoRepeatRegex.FindStringSubmatch(strings.ToUpper(parts[0]))
+		if match == nil {
+			return isoInterval{}, fmt.Errorf("invalid ISO 8601 interval: %s", input)
+		}
+		interval.repetitions = -1
+		if match[1] != "" {
+			var err error
+			if interval.repetitions, err = strconv.Atoi(match[1]); err != nil || interval.repetitions > maxISORepetitions {
+				return isoInterval{}, fmt.Errorf("an ISO 8601 interval can repeat at most %d times: %s", maxISORepetitions, input)
+			}
+		}
+		parts = parts[1:]
+	}
+
+	switch len(parts) {
+	case 1: // a duration up to now
+		var d, err = ParseISODuration(parts[0])
+		if err != nil {
+			return isoInterval{}, err
+		}
+		var now = ht.now()
+		interval.first, interval.step = TimeRange{From: d.SubFrom(now), To: now}, d
+		return interval, nil
+	case 2:
+	default:
+		return isoInterval{}, fmt.Errorf("invalid ISO 8601 interval: %s", input)
+	}
+
+	var start, startStep, startIsDuration, startOpen, err = ht.isoEnd(parts[0])
+	if err != nil {
+		return isoInterval{}, err
+	}
+	end, endStep, endIsDuration, endOpen, err := ht.isoEnd(parts[1])
+	if err != nil {
+		return isoInterval{}, err
+	}
+
+	switch {
+	case startIsDuration && endIsDuration:
+		return isoInterval{}, fmt.Errorf("an ISO 8601 interval cannot be two durations: %s", input)
+	case startIsDuration && endOpen, endIsDuration && startOpen:
+		return isoInterval{}, fmt.Errorf("an ISO 8601 interval cannot be a duration and an open end: %s", input)
+	case startIsDuration:
+		interval.first, interval.step = TimeRange{From: startStep.SubFrom(end), To: end}, startStep
+	case endIsDuration:
+		interval.first, interval.step = TimeRange{From: start, To: endStep.AddTo(start)}, endStep
+	default:
+		interval.first = TimeRange{From: start, To: end, FromUnbounded: startOpen, ToUnbounded: endOpen}
+		interval.step = Duration{Clock: end.Sub(start)}
+	}
+
+	if interval.repetitions != 1 && !interval.first.IsBounded() {
+		return isoInterval{}, fmt.Errorf("a repeating ISO 8601 interval cannot have an open end: %s", input)
+	}
+	return interval, nil
+}
+
+// isoEnd reads one end of an ISO 8601 interval: a duration, ".." for an open
+// end or a date phrase
+func (ht *Humantime) isoEnd(input string) (date time.Time, d Duration, isDuration, open bool, err error) {
+	if input == ".." {
+		return time.Time{}, Duration{}, false, true, nil
+	}
+	if isoDurationRegex.MatchString(strings.ToUpper(input)) {
+		d, err = ParseISODuration(input)
+		return time.Time{}, d, true, false, err
+	}
+	date, err = ht.parseDatePhrase(input)
+	return date, Duration{}, false, false, err
+}
@@ -0,0 +1,174 @@
+package humantime
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseISODuration(t *testing.T) {
+	t.Parallel()
+
+	var cases = map[string]Duration{
+		"P1Y2M3DT4H":           {Years: 1, Months: 2, Days: 3, Clock: 4 * time.Hour},
+		"PT90M":                {Clock: 90 * time.Minute},
+		"P2W":                  {Days: 14},
+		"PT1.5S":               {Clock: 1500 * time.Millisecond},
+		"PT0,25S":              {Clock: 250 * time.Millisecond},
+		"p1dt12h":              {Days: 1, Clock: 12 * time.Hour},
+		"-P1D":                 {Days: -1},
+		"P1Y-2M":               {Years: 1, Months: -2},
+		"PT0S":                 {},
+		"P1W2D":                {Days: 9},
+		"PT36H":                {Clock: 36 * time.Hour},
+		"P0D":                  {},
+		"PT1H30M15.5S":         {Clock: time.Hour + 30*time.Minute + 15500*time.Millisecond},
+		" P3M ":                {Months: 3},
+		"+P1Y":                 {Years: 1},
+		"PT-90M":               {Clock: -90 * time.Minute},
+		"P10Y10M10DT10H10M10S": {Years: 10, Months: 10, Days: 10, Clock: 10*time.Hour + 10*time.Minute + 10*time.Second},
+	}
+	for input, expected := range cases {
+		var result, err = ParseISODuration(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	for _, input := range []string{"", "P", "PT", "P1YT", "1Y", "P1H", "PT1D", "P1.5D", "P1S"} {
+		var _, err = ParseISODuration(input)
+		assert.Equal(t, "invalid ISO 8601 duration: "+input, err.Error(), input)
+	}
+}
+
+func TestDurationISO(t *testing.T) {
+	t.Parallel()
+
+	var cases = map[string]Duration{
+		"P1Y2M3DT4H":     {Years: 1, Months: 2, Days: 3, Clock: 4 * time.Hour},
+		"PT1H30M":        {Clock: 90 * time.Minute},
+		"P14D":           {Days: 14},
+		"PT1.5S":         {Clock: 1500 * time.Millisecond},
+		"PT0S":           {},
+		"-P1DT2H":        {Days: -1, Clock: -2 * time.Hour},
+		"P1Y-2M":         {Years: 1, Months: -2},
+		"PT36H":          {Clock: 36 * time.Hour},
+		"PT1M0.001S":     {Clock: time.Minute + time.Millisecond},
+		"P1YT1H1M1S":     {Years: 1, Clock: time.Hour + time.Minute + time.Second},
+		"PT0.000000001S": {Clock: time.Nanosecond},
+	}
+	for expected, d := range cases {
+		assert.Equal(t, expected, d.ISO(), d.String())
+
+		// round trip
+		var result, err = ParseISODuration(d.ISO())
+		assert.NoError(t, err, expected)
+		assert.Equal(t, d, result, expected)
+	}
+}
+
+func TestISOInterval(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var jan1 = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var cases = map[string]TimeRange{
+		"2024-01-01T00:00Z/P1M":                     {From: jan1, To: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		"P7D/2024-03-01":                            {From: time.Date(2024, time.February, 23, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		"2024-01-01T00:00:00Z/2024-01-02T12:00:00Z": {From: jan1, To: time.Date(2024, time.January, 2, 12, 0, 0, 0, time.UTC)},
+		"PT90M":                    {From: now.Add(-90 * time.Minute), To: now},
+		"../2024-03-01":            {To: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), FromUnbounded: true},
+		"2024-01-01/..":            {From: jan1, ToUnbounded: true},
+		"R5/2024-01-01T00:00Z/P1D": {From: jan1, To: time.Date(2024, time.January, 6, 0, 0, 0, 0, time.UTC)},
+		"R/2024-01-01T00:00Z/P1D":  {From: jan1, ToUnbounded: true},
+		"R2/2024-01-31/P1M":        {From: time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for input, expected := range cases {
+		var result, err = st.ISOInterval(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+
+		result, err = st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+
+	// every repetition
+	ranges, err := st.ParseRanges("R3/2024-01-31/P1M")
+	assert.NoError(t, err)
+	assert.Equal(t, []TimeRange{
+		{From: time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC)},
+		{From: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{From: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)},
+	}, ranges)
+	ranges, err = st.ParseRanges("R3/2024-01-01T09:00Z/PT8H")
+	assert.NoError(t, err)
+	assert.Equal(t, []TimeRange{
+		{From: time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC), To: time.Date(2024, time.January, 1, 17, 0, 0, 0, time.UTC)},
+		{From: time.Date(2024, time.January, 1, 17, 0, 0, 0, time.UTC), To: time.Date(2024, time.January, 2, 1, 0, 0, 0, time.UTC)},
+		{From: time.Date(2024, time.January, 2, 1, 0, 0, 0, time.UTC), To: time.Date(2024, time.January, 2, 9, 0, 0, 0, time.UTC)},
+	}, ranges)
+
+	// phrases with slashes are not intervals
+	result, err := st.Parse("from 3/1/2024 to now-1h/h")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.March, 6, 9, 0, 0, 0, time.UTC)}, *result)
+
+	// errors
+	var errorCases = map[string]string{
+		"P1D/P2D":               "an ISO 8601 interval cannot be two durations: P1D/P2D",
+		"../P1D":                "an ISO 8601 interval cannot be a duration and an open end: ../P1D",
+		"R2/2024-01-01/..":      "a repeating ISO 8601 interval cannot have an open end: R2/2024-01-01/..",
+		"2024-01-01/2024-99-99": "invalid date 2024-99-99",
+	}
+	for input, expected := range errorCases {
+		var _, err = st.ISOInterval(input)
+		assert.Error(t, err, input)
+		if err != nil {
+			assert.Contains(t, err.Error(), expected, input)
+		}
+	}
+	_, err = st.ParseRanges("R/2024-01-01T00:00Z/P1D")
+	assert.Equal(t, "cannot expand a repeating interval without a count: r/2024-01-01t00:00z/p1d", err.Error())
+
+	// the count is capped
+	for _, input := range []string{"R99999999999999999999/2024-01-01T00:00Z/P1D", "R10000000000/2024-01-01T00:00Z/P1D"} {
+		_, err = st.ParseRanges(input)
+		assert.Equal(t, "an ISO 8601 interval can repeat at most 10000 times: "+strings.ToLower(input), err.Error(), input)
+		_, err = st.ISOInterval(input)
+		assert.Equal(t, "an ISO 8601 interval can repeat at most 10000 times: "+input, err.Error(), input)
+	}
+	ranges, err = st.ParseRanges("R10000/2024-01-01T00:00Z/PT1H")
+	assert.NoError(t, err)
+	assert.Len(t, ranges, 10000)
+}
+
+func TestTimeRangeISO(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var cases = map[string]TimeRange{
+		"2024-01-01T00:00:00Z/2024-02-01T00:00:00Z": {From: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		"../2024-03-01T00:00:00Z":                   {To: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), FromUnbounded: true},
+		"2024-03-06T10:30:00.5Z/..":                 {From: now.Add(500 * time.Millisecond), ToUnbounded: true},
+	}
+	for expected, r := range cases {
+		assert.Equal(t, expected, r.ISO())
+
+		// round trip
+		var result, err = st.Parse(r.ISO())
+		assert.NoError(t, err, expected)
+		assert.Equal(t, r, *result, expected)
+	}
+
+	// other zones keep their offset
+	var tokyo = time.FixedZone("JST", 9*60*60)
+	var r = TimeRange{From: time.Date(2024, time.January, 1, 9, 0, 0, 0, tokyo), To: time.Date(2024, time.January, 1, 17, 0, 0, 0, tokyo)}
+	assert.Equal(t, "2024-01-01T09:00:00+09:00/2024-01-01T17:00:00+09:00", r.ISO())
+	result, err := st.Parse(r.ISO())
+	assert.NoError(t, err)
+	assert.True(t, r.Equal(*result))
+}
@@ -0,0 +1,97 @@
+package humantime
+
+import (
+	"strings"
+	"time"
+)
+
+// parseOffset reads phrases like "2 days before code freeze",
+// "1 hour and 30 minutes after yesterday at 3pm", "a week from monday",
+// "the week after next" or "3 hours ago". found is false when the input is
+// not an offset so the caller can try something else.
+func (ht *Humantime) parseOffset(input string) (date time.Time, precision Precision, found bool, err error) {
+	var lower = strings.ToLower(input)
+	var vocab = ht.vocab.load()
+	if rest, found := strings.CutSuffix(lower, " ago"); found {
+		if u, ok := vocab.parseLength(rest); ok {
+			return ago(ht.now(), u), PrecisionSecond, true, nil
+		}
+	}
+	// a signed compact duration like "-15m" or "+1w" is from now
+	if strings.HasPrefix(lower, "-") || strings.HasPrefix(lower, "+") {
+		if u, err := ParseDuration(lower); err == nil {
+			return u.AddTo(ht.now()), PrecisionSecond, true, nil
+		}
+	}
+
+	var loc = offsetRegex.FindStringSubmatchIndex(lower)
+	if loc == nil {
+		return time.Time{}, 0, false, nil
+	}
+
+	u, ok := vocab.parseLength(lower[:loc[0]])
+	if !ok {
+		return time.Time{}, 0, false, nil
+	}
+
+	// "the week after next" is the week after next week
+	var rest = strings.TrimSpace(lower[loc[1]:])
+	if fields := unitFields(lower[:loc[0]]); rest == "next" || rest == "last" || rest == "this" {
+		rest += " " + fields[len(fields)-1]
+	}
+
+	// "a week from monday" counts from the coming monday
+	var before = lower[loc[2]:loc[3]] == "before"
+	var anchor = ht
+	if !before {
+		anchor = ht.preferring(PreferFuture)
+	}
+	date, precision, err = anchor.parsePhrase(rest)
+	if err != nil {
+		return time.Time{}, 0, true, err
+	}
+	precision = min(precision, u.precision())
+	if before {
+		return u.SubFrom(date), precision, true, nil
+	}
+	return u.AddTo(date), precision, true, nil
+}
+
+// parseArithmetic reads phrases like "$start - 15 minutes" or
+// "yesterday at 3pm + 1 day - 2 hours": a date phrase followed by durations
+// to add or subtract. found is false when the input is not arithmetic.
+func (ht *Humantime) parseArithmetic(input string) (date time.Time, precision Precision, found bool, err error) {
+	var lower = strings.ToLower(input)
+	var ops = arithmeticRegex.FindAllStringSubmatchIndex(lower, -1)
+	if ops == nil {
+		return time.Time{}, 0, false, nil
+	}
+
+	// every term after the first operator must be a duration
+	var vocab = ht.vocab.load()
+	var terms = make([]Duration, len(ops))
+	for i, op := range ops {
+		var end = len(lower)
+		if i+1 < len(ops) {
+			end = ops[i+1][0]
+		}
+		var ok bool
+		if terms[i], ok = vocab.parseLength(lower[op[1]:end]); !ok {
+			return time.Time{}, 0, false, nil
+		}
+	}
+
+	date, precision, err = ht.parsePhrase(lower[:ops[0][0]])
+	if err != nil {
+		return time.Time{}, 0, true, err
+	}
+	for i, op := range ops {
+		precision = min(precision, terms[i].precision())
+		if lower[op[2]:op[3]] == "-" {
+			date = terms[i].SubFrom(date)
+		} else {
+			date = terms[i].AddTo(date)
+		}
+	}
+	return date, precision, true, nil
+}
@@ -0,0 +1,76 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOffset(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var cases = map[string]time.Time{
+		"2 hours after yesterday at 3pm":       time.Date(2024, time.March, 5, 17, 0, 0, 0, time.UTC),
+		"3 days before next friday":            time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC),
+		"a week from monday":                   time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC),
+		"2 days after friday":                  time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+		"2 days before friday":                 time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC),
+		"the day after tomorrow":               time.Date(2024, time.March, 8, 0, 0, 0, 0, time.UTC),
+		"the day before yesterday":             time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+		"the week after next":                  time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC),
+		"the month before last":                time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"3 days from now":                      time.Date(2024, time.March, 9, 10, 30, 0, 0, time.UTC),
+		"an hour and 30 mins before 3/15/2024": time.Date(2024, time.March, 14, 22, 30, 0, 0, time.UTC),
+		"1 day after the day after tomorrow":   time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		result, err := st.parseDatePhrase(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	// offsets work after every keyword
+	var ranges = map[string]TimeRange{
+		"since the day before yesterday": {
+			From: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+			To:   now,
+		},
+		"until a week from monday": {
+			From: now,
+			To:   time.Date(2024, time.March, 19, 0, 0, 0, 0, time.UTC),
+		},
+		"from 2 hours after yesterday at 3pm to the day after tomorrow": {
+			From: time.Date(2024, time.March, 5, 17, 0, 0, 0, time.UTC),
+			To:   time.Date(2024, time.March, 8, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for input, expected := range ranges {
+		result, err := st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+
+	// an offset with an unparsable date phrase is an error, not something else
+	_, err := st.parseDatePhrase("2 days before someday")
+	assert.EqualError(t, err, "could not parse someday")
+}
+
+func TestParseArithmetic(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+
+	var cases = map[string]time.Time{
+		"yesterday at 3pm + 1 day - 2 hours": time.Date(2024, time.March, 6, 13, 0, 0, 0, time.UTC),
+		"now - a week":                       time.Date(2024, time.February, 28, 10, 30, 0, 0, time.UTC),
+		"next month + 2 weeks":               time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		result, err := st.parseDatePhrase(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+}
@@ -0,0 +1,203 @@
+package humantime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Option configures a Humantime built by New
+type Option func(*Humantime) error
+
+// New builds a Humantime, the defaults are:
+// location: time.Local, clock: time.Now, week start: Sunday, date order: MDY,
+// fiscal year start: January, language: English, preference: PreferDefault,
+// not strict, two digit year pivot: 69, bare epochs by digits,
+// a LayoutParser using DefaultLayouts for absolute dates and DefaultVocabulary.
+func New(opts ...Option) (*Humantime, error) {
+	var st = &Humantime{
+		location:          time.Local,
+		clock:             time.Now,
+		weekStart:         time.Sunday,
+		fiscalYearStart:   time.January,
+		language:          "en",
+		prefer:            PreferDefault,
+		dateOrder:         MDY,
+		twoDigitYearPivot: 69,
+		absoluteParser:    NewLayoutParser(),
+		vocab:             newVocabHolder(DefaultVocabulary()),
+	}
+
+	for _, opt := range opts {
+		if err := opt(st); err != nil {
+			return nil, err
+		}
+	}
+
+	return st, nil
+}
+
+// WithLocation sets the time zone input is read in and results are returned in
+func WithLocation(loc *time.Location) Option {
+	return func(st *Humantime) error {
+		if loc == nil {
+			return errors.New("location cannot be nil")
+		}
+		st.location = loc
+		return nil
+	}
+}
+
+// WithClock sets the source of the current time, useful for tests
+func WithClock(clock func() time.Time) Option {
+	return func(st *Humantime) error {
+		if clock == nil {
+			return errors.New("clock cannot be nil")
+		}
+		st.clock = clock
+		return nil
+	}
+}
+
+// WithWeekStart sets the first day of the week, it decides what "this", "last"
+// and "next" mean in front of a weekday
+func WithWeekStart(day time.Weekday) Option {
+	return func(st *Humantime) error {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("invalid week start: %d", day)
+		}
+		st.weekStart = day
+		return nil
+	}
+}
+
+// WithFiscalYearStart sets the first month of the year, "this year", "last
+// quarter", "end of the quarter" and "YTD" follow the fiscal calendar
+func WithFiscalYearStart(month time.Month) Option {
+	return func(st *Humantime) error {
+		if month < time.January || month > time.December {
+			return fmt.Errorf("invalid fiscal year start: %d", month)
+		}
+		st.fiscalYearStart = month
+		return nil
+	}
+}
+
+// WithDateOrder sets how numeric dates like 03/04/2024 are read
+func WithDateOrder(order DateOrder) Option {
+	return func(st *Humantime) error {
+		if order < MDY || order > YMD {
+			return fmt.Errorf("invalid date order: %d", order)
+		}
+		st.dateOrder = order
+		return nil
+	}
+}
+
+// WithLanguage sets the language of the input as an ISO 639-1 code,
+// only English ("en") is supported
+func WithLanguage(language string) Option {
+	return func(st *Humantime) error {
+		var vocab, found = languages[language]
+		if !found {
+			return fmt.Errorf("unsupported language: %s", language)
+		}
+		st.language = language
+		st.vocab = newVocabHolder(vocab)
+		return nil
+	}
+}
+
+// WithVocabulary replaces the vocabulary of the language, e.g. one built
+// from DefaultVocabulary with extra synonyms
+func WithVocabulary(vocab *Vocabulary) Option {
+	return func(st *Humantime) error {
+		if vocab == nil {
+			return errors.New("vocabulary cannot be nil")
+		}
+		st.vocab = newVocabHolder(vocab.clone().compile())
+		return nil
+	}
+}
+
+// WithPrefer overrides the past/future preference of every keyword
+func WithPrefer(prefer Prefer) Option {
+	return func(st *Humantime) error {
+		if prefer < PreferDefault || prefer > PreferNearest {
+			return fmt.Errorf("invalid preference: %d", prefer)
+		}
+		st.prefer = prefer
+		return nil
+	}
+}
+
+// WithStrict rejects numeric dates that could be read as two different days
+// e.g. 03/04/2024, instead of reading them in the date order
+func WithStrict(strict bool) Option {
+	return func(st *Humantime) error {
+		st.strict = strict
+		return nil
+	}
+}
+
+// WithTwoDigitYearPivot decides the century of two digit years like 3/15/22:
+// years below the pivot are in the 2000s, the rest in the 1900s
+func WithTwoDigitYearPivot(pivot int) Option {
+	return func(st *Humantime) error {
+		if pivot < 0 || pivot > 100 {
+			return fmt.Errorf("two digit year pivot must be between 0 and 100: %d", pivot)
+		}
+		st.twoDigitYearPivot = pivot
+		return nil
+	}
+}
+
+// WithBareEpoch sets how numbers without '@' or a unit like 1700000000 are
+// read, the default EpochByDigits goes by their number of digits
+func WithBareEpoch(unit EpochUnit) Option {
+	return func(st *Humantime) error {
+		if unit < EpochByDigits || unit > EpochNone {
+			return fmt.Errorf("invalid epoch unit: %d", unit)
+		}
+		st.bareEpoch = unit
+		return nil
+	}
+}
+
+// WithAbsoluteParser replaces the built in LayoutParser for absolute dates
+func WithAbsoluteParser(parser AbsoluteParser) Option {
+	return func(st *Humantime) error {
+		if parser == nil {
+			return errors.New("absolute parser cannot be nil")
+		}
+		st.absoluteParser = parser
+		return nil
+	}
+}
+
+// WithHolidays sets the days "holidays" means in ParseSet phrases like
+// "weekdays last month but not holidays". Only the date of each is used, the
+// time and location are ignored.
+func WithHolidays(days ...time.Time) Option {
+	return func(st *Humantime) error {
+		var holidays = make([]time.Time, len(days))
+		for i, day := range days {
+			holidays[i] = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+		}
+		st.holidays = append(st.holidays[:len(st.holidays):len(st.holidays)], holidays...)
+		return nil
+	}
+}
+
+// WithLayouts adds custom Go time layouts that are tried before the absolute parser
+func WithLayouts(layouts ...string) Option {
+	return func(st *Humantime) error {
+		for _, layout := range layouts {
+			if layout == "" {
+				return errors.New("layout cannot be empty")
+			}
+		}
+		st.layouts = append(st.layouts[:len(st.layouts):len(st.layouts)], layouts...)
+		return nil
+	}
+}
@@ -0,0 +1,116 @@
+package humantime
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	var st, err = New()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Local, st.location)
+	assert.Equal(t, time.Sunday, st.weekStart)
+	assert.Equal(t, "en", st.language)
+	assert.Equal(t, MDY, st.dateOrder)
+	assert.Equal(t, 69, st.twoDigitYearPivot)
+
+	st, err = NewString2Time(time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, st.location)
+
+	// validation
+	var invalid = map[string]Option{
+		"location cannot be nil":                              WithLocation(nil),
+		"clock cannot be nil":                                 WithClock(nil),
+		"invalid week start: 7":                               WithWeekStart(7),
+		"invalid fiscal year start: 13":                       WithFiscalYearStart(13),
+		"invalid epoch unit: 6":                               WithBareEpoch(6),
+		"invalid date order: 3":                               WithDateOrder(3),
+		"unsupported language: fr":                            WithLanguage("fr"),
+		"invalid preference: 4":                               WithPrefer(4),
+		"two digit year pivot must be between 0 and 100: 101": WithTwoDigitYearPivot(101),
+		"absolute parser cannot be nil":                       WithAbsoluteParser(nil),
+		"layout cannot be empty":                              WithLayouts("2006", ""),
+	}
+	for expected, opt := range invalid {
+		st, err = New(opt)
+		assert.Equal(t, expected, err.Error())
+		assert.Nil(t, st)
+	}
+
+	// options do not share state
+	var base = []Option{WithLayouts("2006")}
+	a, err := New(append(base, WithLayouts("Jan"))...)
+	assert.NoError(t, err)
+	b, err := New(append(base, WithLayouts("Mon"))...)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2006", "Jan"}, a.layouts)
+	assert.Equal(t, []string{"2006", "Mon"}, b.layouts)
+}
+
+func TestWithClock(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	result, err := st.Parse("since yesterday")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), result.From)
+	assert.Equal(t, now, result.To)
+
+	result, err = st.Parse("2 days 3 hours ago")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 4, 7, 30, 0, 0, time.UTC), result.From)
+
+	result, err = st.Parse("until 9am")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 7, 9, 0, 0, 0, time.UTC), result.To)
+
+	result, err = st.Parse("since this sunday")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC), result.From)
+}
+
+func TestWithWeekStart(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t, WithWeekStart(time.Monday))
+
+	var cases = map[string]time.Time{
+		"this sunday":   time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+		"this monday":   time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+		"last sunday":   time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC),
+		"next monday":   time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+		"this saturday": time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		result, err := st.parseDatePhrase(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+}
+
+func TestConcurrentParse(t *testing.T) {
+	t.Parallel()
+
+	var st, err = New(WithLocation(time.UTC))
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var _, err = st.Parse("from yesterday at 3pm to next friday")
+			assert.NoError(t, err)
+			_, err = st.ParseWithPrefer("since friday", PreferFuture)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,190 @@
+package humantime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// periods are the calendar periods that can follow "this", "next" and "last"
+var periods = map[string]Precision{
+	"day":     PrecisionDay,
+	"week":    PrecisionWeek,
+	"month":   PrecisionMonth,
+	"quarter": PrecisionQuarter,
+	"year":    PrecisionYear,
+}
+
+// String returns the name of the period e.g. "day"
+func (p Precision) String() string {
+	switch p {
+	case PrecisionSecond:
+		return "second"
+	case PrecisionMinute:
+		return "minute"
+	case PrecisionHour:
+		return "hour"
+	case PrecisionDay:
+		return "day"
+	case PrecisionWeek:
+		return "week"
+	case PrecisionMonth:
+		return "month"
+	case PrecisionQuarter:
+		return "quarter"
+	case PrecisionYear:
+		return "year"
+	}
+	return fmt.Sprintf("Precision(%d)", int(p))
+}
+
+// length returns one period of the precision
+func (p Precision) length() Duration {
+	switch p {
+	case PrecisionMinute:
+		return Duration{Clock: time.Minute}
+	case PrecisionHour:
+		return Duration{Clock: time.Hour}
+	case PrecisionDay:
+		return Duration{Days: 1}
+	case PrecisionWeek:
+		return Duration{Days: 7}
+	case PrecisionMonth:
+		return Duration{Months: 1}
+	case PrecisionQuarter:
+		return Duration{Months: 3}
+	case PrecisionYear:
+		return Duration{Years: 1}
+	}
+	return Duration{Clock: time.Second}
+}
+
+// clockPrecision returns how precise a time of day is, "3pm" is an hour,
+// "3:30pm" a minute and "15:30:10" a second
+func clockPrecision(s string) Precision {
+	return [...]Precision{PrecisionHour, PrecisionMinute, PrecisionSecond}[min(strings.Count(s, ":"), 2)]
+}
+
+// precision returns the coarsest precision that the duration keeps, adding
+// "2 hours" to a day leaves an hour
+func (d Duration) precision() Precision {
+	switch {
+	case d.Clock%time.Minute != 0:
+		return PrecisionSecond
+	case d.Clock%time.Hour != 0:
+		return PrecisionMinute
+	case d.Clock != 0:
+		return PrecisionHour
+	case d.Days%7 != 0:
+		return PrecisionDay
+	case d.Days != 0:
+		return PrecisionWeek
+	case d.Months%3 != 0:
+		return PrecisionMonth
+	case d.Months != 0:
+		return PrecisionQuarter
+	}
+	return PrecisionYear
+}
+
+// startOf returns the start of the period containing t in the location of
+// the Humantime, weeks start on weekStart and years on fiscalYearStart
+func (ht *Humantime) startOf(t time.Time, p Precision) time.Time {
+	t = t.In(ht.location)
+	var fiscalMonth = time.Month((int(t.Month()) - int(ht.fiscalYearStart) + 12) % 12) // months into the fiscal year
+	switch p {
+	case PrecisionMinute:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, ht.location)
+	case PrecisionHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, ht.location)
+	case PrecisionDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, ht.location)
+	case PrecisionWeek:
+		return time.Date(t.Year(), t.Month(), t.Day()-(int(t.Weekday())-int(ht.weekStart)+7)%7, 0, 0, 0, 0, ht.location)
+	case PrecisionMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, ht.location)
+	case PrecisionQuarter:
+		return time.Date(t.Year(), t.Month()-fiscalMonth%3, 1, 0, 0, 0, 0, ht.location)
+	case PrecisionYear:
+		return time.Date(t.Year(), t.Month()-fiscalMonth, 1, 0, 0, 0, 0, ht.location)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, ht.location)
+}
+
+// endOf returns the start of the period after the one containing t
+func (ht *Humantime) endOf(t time.Time, p Precision) time.Time {
+	return p.length().AddTo(ht.startOf(t, p))
+}
+
+// periodEnd returns where the period of a date phrase ends: phrases of a day
+// or longer like "friday" cover the whole period, shorter ones like "3pm" are
+// instants and end where they start
+func (ht *Humantime) periodEnd(date time.Time, p Precision) time.Time {
+	if p < PrecisionDay {
+		return date
+	}
+	return ht.endOf(date, p)
+}
+
+// parsePeriod resolves the submatches of periodRegex e.g. "next month" to the
+// start of the period
+func (ht *Humantime) parsePeriod(now time.Time, match []string) (time.Time, Precision) {
+	var p = periods[match[2]]
+	var start = ht.startOf(now, p)
+	switch match[1] {
+	case "next":
+		return p.length().AddTo(start), p
+	case "last":
+		return p.length().SubFrom(start), p
+	}
+	return start, p
+}
+
+// closeOfBusiness is the time of day "close of business" and "COB" mean
+const closeOfBusiness = 17 * time.Hour
+
+// parseBoundary reads phrases like "start of this week", "beginning of next
+// month", "end of the quarter", "end of day", "EOD friday" or "close of
+// business". They are instants: the start of the period or the start of the
+// one after it. found is false when the input is not a boundary.
+func (ht *Humantime) parseBoundary(input string) (date time.Time, precision Precision, found bool, err error) {
+	var match = boundaryRegex.FindStringSubmatch(strings.ToLower(input))
+	if match == nil {
+		return time.Time{}, 0, false, nil
+	}
+	var keyword = strings.Join(strings.Fields(match[1]), " ")
+	var rest = strings.TrimSpace(match[2])
+
+	// "end of day friday" is the end of the day friday is in, a period on its
+	// own like "end of the quarter" is the current one
+	var fields = strings.Fields(rest)
+	var p, named = PrecisionDay, false
+	if len(fields) > 0 {
+		p, named = periods[fields[0]]
+		if named {
+			rest = strings.Join(fields[1:], " ")
+		}
+	}
+
+	// "EOD friday" is a deadline, the coming friday unless told otherwise
+	date = ht.now()
+	if rest != "" {
+		var phrasePrecision Precision
+		if date, phrasePrecision, err = ht.preferring(PreferFuture).parsePhrase(rest); err != nil {
+			return time.Time{}, 0, true, err
+		}
+		if !named {
+			p = phrasePrecision
+		}
+	} else if !named && (keyword == "start of" || keyword == "beginning of" || keyword == "end of") {
+		return time.Time{}, 0, true, fmt.Errorf("could not parse %s", input)
+	}
+
+	switch keyword {
+	case "start of", "beginning of":
+		return ht.startOf(date, p), PrecisionSecond, true, nil
+	case "cob", "close of business":
+		return ht.startOf(date, PrecisionDay).Add(closeOfBusiness), PrecisionSecond, true, nil
+	}
+	return ht.endOf(date, p), PrecisionSecond, true, nil
+}
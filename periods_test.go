@@ -0,0 +1,187 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+	var _, monday = fixedNow(t, WithWeekStart(time.Monday))
+
+	var cases = map[string]time.Time{
+		"this week":        time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC),
+		"next week":        time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+		"last week":        time.Date(2024, time.February, 25, 0, 0, 0, 0, time.UTC),
+		"this month":       time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		"next month":       time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+		"last month":       time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		"this quarter":     time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"next quarter":     time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+		"last year":        time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"next day":         time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC),
+		"next week at 3pm": time.Date(2024, time.March, 10, 15, 0, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		result, err := st.parseDatePhrase(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	// weeks honor the week start
+	result, err := monday.parseDatePhrase("this week")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), result)
+	result, err = monday.parseDatePhrase("the week after next")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC), result)
+}
+
+func TestParsePrecision(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+
+	var cases = map[string]Precision{
+		"now":                     PrecisionSecond,
+		"yesterday":               PrecisionDay,
+		"friday":                  PrecisionDay,
+		"3/15/2024":               PrecisionDay,
+		"May 8, 2009":             PrecisionDay,
+		"May 8, 2009 5:57:51 PM":  PrecisionSecond,
+		"yesterday at 3pm":        PrecisionHour,
+		"yesterday at 3:30pm":     PrecisionMinute,
+		"yesterday at 15:30:10":   PrecisionSecond,
+		"next week":               PrecisionWeek,
+		"last month":              PrecisionMonth,
+		"this quarter":            PrecisionQuarter,
+		"next year":               PrecisionYear,
+		"the day after tomorrow":  PrecisionDay,
+		"the week after next":     PrecisionWeek,
+		"2 hours after yesterday": PrecisionHour,
+		"3 days from now":         PrecisionSecond,
+		"next month + 2 weeks":    PrecisionWeek,
+		"last year - 1 day":       PrecisionDay,
+		"yesterday + 90 minutes":  PrecisionMinute,
+	}
+	for input, expected := range cases {
+		_, precision, err := st.parsePhrase(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, precision, input)
+	}
+
+	assert.Equal(t, "quarter", PrecisionQuarter.String())
+	assert.Equal(t, "Precision(42)", Precision(42).String())
+}
+
+func TestPrecisionRanges(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var cases = map[string]TimeRange{
+		"since yesterday":  {From: time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), To: now},
+		"until friday":     {From: now, To: time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC)},
+		"until next month": {From: now, To: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		"until 3pm":        {From: now, To: time.Date(2024, time.March, 6, 15, 0, 0, 0, time.UTC)},
+		"after friday":     {From: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC), ToUnbounded: true},
+		"after last year":  {From: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), ToUnbounded: true},
+		"after 3pm":        {From: time.Date(2024, time.March, 5, 15, 0, 0, 0, time.UTC), ToUnbounded: true, FromExclusive: true},
+		"before friday":    {To: time.Date(2024, time.March, 8, 0, 0, 0, 0, time.UTC), FromUnbounded: true},
+	}
+	for input, expected := range cases {
+		result, err := st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+}
+
+func TestParseBoundary(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var cases = map[string]time.Time{
+		"start of this week":        time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC),
+		"beginning of next month":   time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+		"end of last month":         time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		"end of the quarter":        time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+		"start of the year":         time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"end of day":                time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC),
+		"end of the day":            time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC),
+		"end of day friday":         time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC),
+		"end of friday":             time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC),
+		"end of last friday":        time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+		"EOD":                       time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC),
+		"EOD friday":                time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC),
+		"EOD tomorrow":              time.Date(2024, time.March, 8, 0, 0, 0, 0, time.UTC),
+		"close of business":         time.Date(2024, time.March, 6, 17, 0, 0, 0, time.UTC),
+		"COB friday":                time.Date(2024, time.March, 8, 17, 0, 0, 0, time.UTC),
+		"2 hours before end of day": time.Date(2024, time.March, 6, 22, 0, 0, 0, time.UTC),
+		"end of next week - 1 day":  time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		result, err := st.ParseTime(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	_, err := st.ParseTime("start of")
+	assert.Equal(t, "could not parse start of", err.Error())
+	_, err = st.ParseTime("end of nothing")
+	assert.Equal(t, "could not parse nothing", err.Error())
+
+	// boundaries are instants
+	result, err := st.Parse("until end of day")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: now, To: time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC)}, *result)
+	result, err = st.Parse("before eod friday")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{To: time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC), FromUnbounded: true}, *result)
+	result, err = st.ParseWithPrefer("before eod friday", PreferPast)
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{To: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC), FromUnbounded: true}, *result)
+	result, err = st.Parse("until close of business")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: now, To: time.Date(2024, time.March, 6, 17, 0, 0, 0, time.UTC)}, *result)
+
+	// weeks honor the week start
+	var _, monday = fixedNow(t, WithWeekStart(time.Monday))
+	date, err := monday.ParseTime("end of this week")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), date)
+}
+
+func TestFiscalYear(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t, WithFiscalYearStart(time.October))
+
+	var cases = map[string]time.Time{
+		"this year":          time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC),
+		"next year":          time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC),
+		"this quarter":       time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"last quarter":       time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC),
+		"end of the quarter": time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+		"end of the year":    time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC),
+		"this month":         time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		result, err := st.ParseTime(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	// quarters do not have to line up with the calendar ones
+	_, st = fixedNow(t, WithFiscalYearStart(time.February))
+	result, err := st.ParseTime("this quarter")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), result)
+	result, err = st.ParseTime("start of the year")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), result)
+}
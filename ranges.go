@@ -0,0 +1,172 @@
+package humantime
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// everyWeekday is every day of the week, for a time window without days
+var everyWeekday = []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+
+// ParseRanges parses phrases that expand to many ranges, examples:
+// every monday last month
+// weekdays between 9am and 5pm this week
+// the last 5 fridays
+// mon, wed and fri at 2pm-4pm
+// R5/2024-01-01T00:00Z/P1D
+// Days of the week are expanded within the period that follows them, this
+// week when there is none. Each day is a range of its own, limited to the
+// time window when there is one. The ranges are in order.
+func (st *Humantime) ParseRanges(input string) ([]TimeRange, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	input = strings.NewReplacer("–", "-", "—", "-").Replace(input)
+	if input == "" {
+		return nil, errors.New("input cannot be empty")
+	}
+	if looksISO(input) {
+		return st.isoRanges(input)
+	}
+
+	var from, to string
+	if match := timesBetweenRegex.FindStringSubmatchIndex(input); match != nil {
+		if match[2] >= 0 {
+			from, to = input[match[2]:match[3]], input[match[4]:match[5]]
+		} else {
+			from, to = input[match[6]:match[7]], input[match[8]:match[9]]
+		}
+		input = strings.Join(strings.Fields(input[:match[0]]+" "+input[match[1]:]), " ")
+	}
+
+	if match := countedDaysRegex.FindStringSubmatch(input); match != nil {
+		if ranges, found, err := st.countedDays(match, from, to); found {
+			return ranges, err
+		}
+	}
+
+	var weekdays, rest = leadingWeekdays(st.vocab.load(), input)
+	if weekdays == nil && from != "" {
+		weekdays = everyWeekday
+	}
+
+	var bounds = []TimeRange{{From: st.startOf(st.now(), PrecisionWeek), To: st.endOf(st.now(), PrecisionWeek)}}
+	if rest != "" {
+		var set, err = st.ParseSet(rest)
+		if err != nil {
+			return nil, err
+		}
+		bounds = set.Ranges()
+	}
+	if weekdays == nil {
+		return bounds, nil
+	}
+
+	var ranges []TimeRange
+	for _, r := range bounds {
+		var days, err = st.daysIn(r, weekdays)
+		if err != nil {
+			return nil, err
+		}
+		for _, day := range days {
+			if from != "" {
+				window, err := st.window(st.startOf(day.From, PrecisionDay), from, to)
+				if err != nil {
+					return nil, err
+				}
+				day = r.Intersect(window)
+			}
+			if !day.IsZero() {
+				ranges = append(ranges, day)
+			}
+		}
+	}
+	return ranges, nil
+}
+
+// leadingWeekdays reads a list of days of the week at the start of the input
+// like "every monday", "mondays and wednesdays" or "mon, wed and fri" and
+// returns the rest of the input
+func leadingWeekdays(vocab *Vocabulary, input string) ([]time.Weekday, string) {
+	var fields = strings.Fields(strings.ReplaceAll(input, ",", " , "))
+	var i int
+	if len(fields) > 1 && (fields[0] == "every" || fields[0] == "on") {
+		i++
+	}
+	if i == 1 && (fields[1] == "day" || fields[1] == "days") {
+		return everyWeekday, strings.Join(fields[2:], " ")
+	}
+
+	var weekdays []time.Weekday
+	for i < len(fields) {
+		var days, found = weekdaysOf(vocab, fields[i])
+		if !found {
+			break
+		}
+		weekdays = append(weekdays, days...)
+		i++
+
+		// separators only count when another day follows them
+		var next = i
+		for next < len(fields) && (fields[next] == "," || fields[next] == "and" || fields[next] == "&") {
+			next++
+		}
+		if next > i && next < len(fields) {
+			if _, found := weekdaysOf(vocab, fields[next]); found {
+				i = next
+			}
+		}
+	}
+	if weekdays == nil {
+		return nil, input
+	}
+	return weekdays, strings.Join(fields[i:], " ")
+}
+
+// maxCountedDays is the largest count "the last 5 fridays" may have
+const maxCountedDays = 10000
+
+// countedDays expands the submatches of countedDaysRegex like "the last 5
+// fridays", the days are counted from yesterday back or from tomorrow on.
+// found is false when the unit is not a day of the week, e.g. "the last 5 hours".
+func (ht *Humantime) countedDays(match []string, from, to string) (ranges []TimeRange, found bool, err error) {
+	weekdays, found := weekdaysOf(ht.vocab.load(), match[3])
+	if !found {
+		return nil, false, nil
+	}
+	count, err := strconv.Atoi(match[2])
+	if err != nil || count < 1 {
+		return nil, true, fmt.Errorf("invalid number of days: %s", match[2])
+	}
+	if count > maxCountedDays {
+		return nil, true, fmt.Errorf("at most %d days can be counted: %s", maxCountedDays, match[2])
+	}
+
+	var step = 1
+	switch match[1] {
+	case "last", "past", "previous":
+		step = -1
+	}
+
+	var day = ht.startOf(ht.now(), PrecisionDay)
+	for len(ranges) < count {
+		day = day.AddDate(0, 0, step)
+		if !slices.Contains(weekdays, day.Weekday()) {
+			continue
+		}
+		var r = TimeRange{From: day, To: day.AddDate(0, 0, 1)}
+		if from != "" {
+			if r, err = ht.window(day, from, to); err != nil {
+				return nil, true, err
+			}
+		}
+		ranges = append(ranges, r)
+	}
+
+	if step < 0 {
+		slices.Reverse(ranges)
+	}
+	return ranges, true, nil
+}
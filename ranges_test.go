@@ -0,0 +1,82 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// hours returns the range between two hours of a day in March 2024 in UTC
+func hours(day, from, to int) TimeRange {
+	return TimeRange{From: march(day, from), To: march(day, to)}
+}
+
+func TestParseRanges(t *testing.T) {
+	t.Parallel()
+
+	var _, st = fixedNow(t)
+
+	var cases = map[string][]TimeRange{
+		"every monday last month": {
+			{From: february(5), To: february(6)},
+			{From: february(12), To: february(13)},
+			{From: february(19), To: february(20)},
+			{From: february(26), To: february(27)},
+		},
+		"weekdays between 9am and 5pm this week": {hours(4, 9, 17), hours(5, 9, 17), hours(6, 9, 17), hours(7, 9, 17), hours(8, 9, 17)},
+		"the last 5 fridays": {
+			{From: february(2), To: february(3)},
+			{From: february(9), To: february(10)},
+			{From: february(16), To: february(17)},
+			{From: february(23), To: february(24)},
+			{From: march(1, 0), To: march(2, 0)},
+		},
+		"the next 2 fridays at 2pm-4pm":              {hours(8, 14, 16), hours(15, 14, 16)},
+		"mon, wed and fri at 2pm–4pm":                {hours(4, 14, 16), hours(6, 14, 16), hours(8, 14, 16)},
+		"Mondays and Wednesdays between 9am and 5pm": {hours(4, 9, 17), hours(6, 9, 17)},
+		"mon, wed, and fri":                          {hours(4, 0, 24), hours(6, 0, 24), hours(8, 0, 24)},
+		"between 9am and 5pm yesterday":              {hours(5, 9, 17)},
+		"9:30-17:00 today":                           {{From: march(6, 9).Add(30 * time.Minute), To: march(6, 17)}},
+		"last month":                                 {{From: february(1), To: march(1, 0)}},
+		"weekdays last week except monday": {
+			{From: february(27), To: february(28)},
+			{From: february(28), To: february(29)},
+			{From: february(29), To: march(1, 0)},
+			{From: march(1, 0), To: march(2, 0)},
+		},
+		"every day this week from 10pm to 2am": {
+			{From: march(3, 22), To: march(4, 2)},
+			{From: march(4, 22), To: march(5, 2)},
+			{From: march(5, 22), To: march(6, 2)},
+			{From: march(6, 22), To: march(7, 2)},
+			{From: march(7, 22), To: march(8, 2)},
+			{From: march(8, 22), To: march(9, 2)},
+			{From: march(9, 22), To: march(10, 0)},
+		},
+	}
+	for input, expected := range cases {
+		result, err := st.ParseRanges(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	var errorCases = map[string]string{
+		"":                                "input cannot be empty",
+		"the last 0 fridays":              "invalid number of days: 0",
+		"the last 5 apples":               "could not parse the last 5 apples",
+		"the last 99999999999999 fridays": "at most 10000 days can be counted: 99999999999999",
+		"every monday after friday":       "cannot expand days of the week in an unbounded range",
+		"mondays between 9am and 13pm":    "error parsing time window 9am to 13pm, err: error parsing hour (13) in: 13pm, err: hour cannot be > 12",
+		"mondays in the summer":           "could not parse in the summer",
+	}
+	for input, expected := range errorCases {
+		_, err := st.ParseRanges(input)
+		assert.EqualError(t, err, expected, input)
+	}
+
+	// counts of other units are rolling windows
+	result, err := st.ParseRanges("the last 5 hours")
+	assert.NoError(t, err)
+	assert.Equal(t, []TimeRange{{From: march(6, 5).Add(30 * time.Minute), To: march(6, 10).Add(30 * time.Minute)}}, result)
+}
@@ -0,0 +1,180 @@
+package humantime
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RangeSet is a set of instants made of TimeRanges, e.g. "last week except
+// weekends". The ranges are kept sorted, none of them are empty and they
+// neither overlap nor touch. The zero RangeSet is empty and ready to use,
+// like TimeRange the methods return a modified copy.
+type RangeSet struct {
+	ranges []TimeRange
+}
+
+// NewRangeSet returns the set of instants in any of the ranges
+func NewRangeSet(ranges ...TimeRange) RangeSet {
+	return RangeSet{}.Add(ranges...)
+}
+
+// Add returns the set with the ranges added, overlapping and touching
+// ranges are merged
+func (s RangeSet) Add(ranges ...TimeRange) RangeSet {
+	var all = make([]TimeRange, 0, len(s.ranges)+len(ranges))
+	all = append(all, s.ranges...)
+	for _, r := range ranges {
+		if !r.IsZero() {
+			all = append(all, r)
+		}
+	}
+	slices.SortStableFunc(all, func(a, b TimeRange) int {
+		switch {
+		case startsBefore(a, b):
+			return -1
+		case startsBefore(b, a):
+			return 1
+		}
+		return 0
+	})
+
+	var merged []TimeRange
+	for _, r := range all {
+		if len(merged) > 0 {
+			if union, ok := merged[len(merged)-1].Union(r); ok {
+				merged[len(merged)-1] = union
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return RangeSet{ranges: merged}
+}
+
+// Subtract returns the set without the instants in any of the ranges
+func (s RangeSet) Subtract(ranges ...TimeRange) RangeSet {
+	var result = s.ranges
+	for _, r := range ranges {
+		var remaining []TimeRange
+		for _, kept := range result {
+			for _, outside := range complement(r) {
+				if part := kept.Intersect(outside); !part.IsZero() {
+					remaining = append(remaining, part)
+				}
+			}
+		}
+		result = remaining
+	}
+	return NewRangeSet(result...)
+}
+
+// Intersect returns the instants of the set that are also in one of the ranges
+func (s RangeSet) Intersect(ranges ...TimeRange) RangeSet {
+	var result []TimeRange
+	for _, kept := range s.ranges {
+		for _, r := range ranges {
+			if part := kept.Intersect(r); !part.IsZero() {
+				result = append(result, part)
+			}
+		}
+	}
+	return NewRangeSet(result...)
+}
+
+// Union returns the instants in either set
+func (s RangeSet) Union(other RangeSet) RangeSet {
+	return s.Add(other.ranges...)
+}
+
+// Gaps returns the parts of bounds the set does not cover
+func (s RangeSet) Gaps(bounds TimeRange) RangeSet {
+	return NewRangeSet(bounds).Subtract(s.ranges...)
+}
+
+// Contains reports whether t is in one of the ranges
+func (s RangeSet) Contains(t time.Time) bool {
+	for _, r := range s.ranges {
+		if r.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Duration returns the total time covered, a set with an unbounded range is
+// as long as time.Duration allows
+func (s RangeSet) Duration() time.Duration {
+	var total time.Duration
+	for _, r := range s.ranges {
+		var d = r.Duration()
+		if d > maxDuration-total {
+			return maxDuration
+		}
+		total += d
+	}
+	return total
+}
+
+// Equal reports whether both sets contain the same instants
+func (s RangeSet) Equal(other RangeSet) bool {
+	return slices.EqualFunc(s.ranges, other.ranges, TimeRange.Equal)
+}
+
+// Bounds returns the smallest range covering the set
+func (s RangeSet) Bounds() TimeRange {
+	if len(s.ranges) == 0 {
+		return TimeRange{}
+	}
+	// the ranges are sorted and do not overlap so the last one ends last
+	var bounds = s.ranges[0]
+	var last = s.ranges[len(s.ranges)-1]
+	bounds.To, bounds.ToUnbounded, bounds.ToInclusive = last.To, last.ToUnbounded, last.ToInclusive
+	return bounds
+}
+
+// IsZero reports whether the set contains no instant
+func (s RangeSet) IsZero() bool {
+	return len(s.ranges) == 0
+}
+
+// Len returns the number of ranges in the set
+func (s RangeSet) Len() int {
+	return len(s.ranges)
+}
+
+// Ranges returns a copy of the ranges in order
+func (s RangeSet) Ranges() []TimeRange {
+	return slices.Clone(s.ranges)
+}
+
+// All iterates over the ranges in order
+func (s RangeSet) All() iter.Seq[TimeRange] {
+	return slices.Values(s.ranges)
+}
+
+// String returns the ranges in order separated by semicolons
+func (s RangeSet) String() string {
+	var ranges = make([]string, len(s.ranges))
+	for i, r := range s.ranges {
+		ranges[i] = r.String()
+	}
+	return strings.Join(ranges, "; ")
+}
+
+// complement returns the ranges of instants outside r, at most one before
+// and one after it
+func complement(r TimeRange) []TimeRange {
+	if r.IsZero() {
+		return []TimeRange{{FromUnbounded: true, ToUnbounded: true}}
+	}
+	var outside []TimeRange
+	if !r.FromUnbounded {
+		outside = append(outside, TimeRange{To: r.From, FromUnbounded: true, ToInclusive: r.FromExclusive})
+	}
+	if !r.ToUnbounded {
+		outside = append(outside, TimeRange{From: r.To, ToUnbounded: true, FromExclusive: r.ToInclusive})
+	}
+	return outside
+}
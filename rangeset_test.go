@@ -0,0 +1,112 @@
+package humantime
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeSetAdd(t *testing.T) {
+	t.Parallel()
+
+	var set = NewRangeSet(
+		TimeRange{From: at(13), To: at(15)},
+		TimeRange{From: at(9), To: at(11)},
+		TimeRange{From: at(10), To: at(12)},
+		TimeRange{From: at(12), To: at(13)},
+		TimeRange{From: at(20), To: at(18)}, // empty
+		TimeRange{From: at(16), To: at(17), FromExclusive: true},
+	)
+	assert.Equal(t, []TimeRange{
+		{From: at(9), To: at(15)},
+		{From: at(16), To: at(17), FromExclusive: true},
+	}, set.Ranges())
+	assert.Equal(t, 2, set.Len())
+	assert.Equal(t, 7*time.Hour, set.Duration())
+	assert.True(t, set.Contains(at(12)))
+	assert.False(t, set.Contains(at(16)))
+	assert.Equal(t, set.Ranges(), slices.Collect(set.All()))
+
+	// a range with an open point between them does not merge
+	set = set.Add(TimeRange{From: at(15), To: at(16), FromExclusive: true})
+	assert.Equal(t, 3, set.Len())
+	set = set.Add(TimeRange{From: at(15), To: at(16), ToInclusive: true})
+	assert.Equal(t, []TimeRange{{From: at(9), To: at(17)}}, set.Ranges())
+
+	assert.True(t, RangeSet{}.IsZero())
+	assert.Equal(t, time.Duration(0), RangeSet{}.Duration())
+	assert.Equal(t, maxDuration, NewRangeSet(TimeRange{From: at(9), ToUnbounded: true}, TimeRange{From: at(1), To: at(2)}).Duration())
+}
+
+func TestRangeSetSubtract(t *testing.T) {
+	t.Parallel()
+
+	var day = NewRangeSet(TimeRange{From: at(0), To: at(24)})
+
+	var set = day.Subtract(TimeRange{From: at(12), To: at(13)}, TimeRange{From: at(18), To: at(20)})
+	assert.Equal(t, []TimeRange{
+		{From: at(0), To: at(12)},
+		{From: at(13), To: at(18)},
+		{From: at(20), To: at(24)},
+	}, set.Ranges())
+	assert.Equal(t, 21*time.Hour, set.Duration())
+
+	// subtracting an inclusive end leaves an exclusive start
+	set = day.Subtract(TimeRange{FromUnbounded: true, To: at(9), ToInclusive: true})
+	assert.Equal(t, []TimeRange{{From: at(9), To: at(24), FromExclusive: true}}, set.Ranges())
+
+	// subtracting everything
+	assert.True(t, day.Subtract(TimeRange{FromUnbounded: true, ToUnbounded: true}).IsZero())
+
+	// subtracting nothing
+	assert.Equal(t, day, day.Subtract(TimeRange{}))
+
+	// unbounded sets
+	set = NewRangeSet(TimeRange{From: at(9), ToUnbounded: true}).Subtract(TimeRange{From: at(12), To: at(13)})
+	assert.Equal(t, []TimeRange{{From: at(9), To: at(12)}, {From: at(13), ToUnbounded: true}}, set.Ranges())
+}
+
+func TestRangeSetIntersect(t *testing.T) {
+	t.Parallel()
+
+	var set = NewRangeSet(TimeRange{From: at(0), To: at(6)}, TimeRange{From: at(12), To: at(18)})
+	var work = set.Intersect(TimeRange{From: at(5), To: at(13)}, TimeRange{From: at(17), To: at(20)})
+	assert.Equal(t, []TimeRange{
+		{From: at(5), To: at(6)},
+		{From: at(12), To: at(13)},
+		{From: at(17), To: at(18)},
+	}, work.Ranges())
+
+	var union = set.Union(NewRangeSet(TimeRange{From: at(6), To: at(12)}))
+	assert.Equal(t, []TimeRange{{From: at(0), To: at(18)}}, union.Ranges())
+}
+
+func TestRangeSetGaps(t *testing.T) {
+	t.Parallel()
+
+	var meetings = NewRangeSet(TimeRange{From: at(10), To: at(11)}, TimeRange{From: at(13), To: at(15)})
+	var free = meetings.Gaps(TimeRange{From: at(9), To: at(17)})
+	assert.Equal(t, []TimeRange{
+		{From: at(9), To: at(10)},
+		{From: at(11), To: at(13)},
+		{From: at(15), To: at(17)},
+	}, free.Ranges())
+	assert.Equal(t, 5*time.Hour, free.Duration())
+	assert.Equal(t, "From: Wed, 06 Mar 2024 09:00:00 UTC, To: Wed, 06 Mar 2024 10:00:00 UTC; "+
+		"From: Wed, 06 Mar 2024 11:00:00 UTC, To: Wed, 06 Mar 2024 13:00:00 UTC; "+
+		"From: Wed, 06 Mar 2024 15:00:00 UTC, To: Wed, 06 Mar 2024 17:00:00 UTC", free.String())
+
+	assert.True(t, RangeSet{}.Gaps(TimeRange{From: at(9), To: at(17)}).Equal(NewRangeSet(TimeRange{From: at(9), To: at(17)})))
+}
+
+func TestRangeSetBounds(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, TimeRange{}, RangeSet{}.Bounds())
+	assert.Equal(t, TimeRange{From: at(9), To: at(17), ToInclusive: true},
+		NewRangeSet(TimeRange{From: at(12), To: at(17), ToInclusive: true}, TimeRange{From: at(9), To: at(10)}).Bounds())
+	assert.Equal(t, TimeRange{FromUnbounded: true, To: at(17)},
+		NewRangeSet(TimeRange{From: at(12), To: at(17)}, TimeRange{FromUnbounded: true, To: at(10)}).Bounds())
+}
@@ -0,0 +1,214 @@
+package humantime
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// dayClasses are the words that stand for several days of the week
+var dayClasses = map[string][]time.Weekday{
+	"weekdays": {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekday":  {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekends": {time.Saturday, time.Sunday},
+	"weekend":  {time.Saturday, time.Sunday},
+}
+
+// ParseSet parses phrases that can describe several ranges, like exclusions:
+// "last month excluding weekends", "this week except friday",
+// "yesterday except 12pm to 1pm" or "weekdays last month but not holidays".
+// Anything Parse accepts is a set of one range, and a date phrase like
+// "last month" or "yesterday" is the whole period.
+func (st *Humantime) ParseSet(input string) (RangeSet, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if input == "" {
+		return RangeSet{}, errors.New("input cannot be empty")
+	}
+
+	var parts = exclusionRegex.Split(input, -1)
+	var set, err = st.parseSetPhrase(parts[0], nil)
+	if err != nil {
+		return RangeSet{}, err
+	}
+
+	// exclusions are relative to the whole base e.g. every friday in it
+	var bounds = set.Bounds()
+	for _, part := range parts[1:] {
+		excluded, err := st.parseExclusion(part, bounds)
+		if err != nil {
+			return RangeSet{}, err
+		}
+		set = set.Subtract(excluded.Ranges()...)
+	}
+	return set, nil
+}
+
+// parseExclusion reads what follows "except", which can be a list
+// like "friday and saturday"
+func (ht *Humantime) parseExclusion(input string, bounds TimeRange) (RangeSet, error) {
+	var set, err = ht.parseSetPhrase(input, &bounds)
+	if err == nil {
+		return set, nil
+	}
+
+	var items = listSeparatorRegex.Split(input, -1)
+	if len(items) == 1 {
+		return RangeSet{}, err
+	}
+	set = RangeSet{}
+	for _, item := range items {
+		excluded, err := ht.parseSetPhrase(item, &bounds)
+		if err != nil {
+			return RangeSet{}, err
+		}
+		set = set.Union(excluded)
+	}
+	return set, nil
+}
+
+// parseSetPhrase reads a single item of a set phrase. Days of the week like
+// "weekends" or "fridays" and daily windows like "12pm to 1pm" need bounds to
+// be expanded in, when there are none they must be followed by a phrase giving
+// them e.g. "weekdays last month".
+func (ht *Humantime) parseSetPhrase(input string, bounds *TimeRange) (RangeSet, error) {
+	input = strings.TrimSpace(input)
+	var vocab = ht.vocab.load()
+
+	if bounds != nil {
+		if days, found := weekdaysOf(vocab, input); found {
+			return ht.everyDay(*bounds, days)
+		}
+		if input == "holidays" || input == "holiday" {
+			return ht.holidaysIn(*bounds)
+		}
+		if match := timeWindowRegex.FindStringSubmatch(input); match != nil &&
+			atTimeRegex.FindString(match[1]) == match[1] && atTimeRegex.FindString(match[2]) == match[2] {
+			return ht.dailyWindow(*bounds, match[1], match[2])
+		}
+	}
+
+	// "weekdays last month" filters the range of the rest of the phrase,
+	// "friday at 3pm" is a date phrase
+	if first, rest, found := strings.Cut(input, " "); found {
+		var _, singular = vocab.weekdays[first]
+		if days, found := weekdaysOf(vocab, first); found && !singular {
+			var base, err = ht.parseSetPhrase(rest, nil)
+			if err != nil {
+				return RangeSet{}, err
+			}
+			var set RangeSet
+			for r := range base.All() {
+				matching, err := ht.everyDay(r, days)
+				if err != nil {
+					return RangeSet{}, err
+				}
+				set = set.Union(matching)
+			}
+			return set, nil
+		}
+	}
+
+	var tr, err = ht.Parse(input)
+	if err == nil {
+		return NewRangeSet(*tr), nil
+	}
+	if !errors.Is(err, errUnsupportedFormat) {
+		return RangeSet{}, err
+	}
+
+	date, precision, err := ht.parsePhrase(input)
+	if err != nil {
+		return RangeSet{}, err
+	}
+	return NewRangeSet(TimeRange{From: ht.startOf(date, precision), To: ht.endOf(date, precision)}), nil
+}
+
+// weekdaysOf returns the days of the week a word stands for: "weekends",
+// "friday" or "fridays"
+func weekdaysOf(vocab *Vocabulary, word string) ([]time.Weekday, bool) {
+	if days, found := dayClasses[word]; found {
+		return days, true
+	}
+	if day, found := vocab.weekdays[word]; found {
+		return []time.Weekday{day}, true
+	}
+	if day, found := vocab.weekdays[strings.TrimSuffix(word, "s")]; found && strings.HasSuffix(word, "s") {
+		return []time.Weekday{day}, true
+	}
+	return nil, false
+}
+
+// everyDay returns the parts of bounds on the days of the week
+func (ht *Humantime) everyDay(bounds TimeRange, days []time.Weekday) (RangeSet, error) {
+	var ranges, err = ht.daysIn(bounds, days)
+	return NewRangeSet(ranges...), err
+}
+
+// daysIn returns the parts of bounds on the days of the week, one range a day
+func (ht *Humantime) daysIn(bounds TimeRange, days []time.Weekday) ([]TimeRange, error) {
+	if !bounds.IsBounded() {
+		return nil, errors.New("cannot expand days of the week in an unbounded range")
+	}
+
+	var ranges []TimeRange
+	for day := ht.startOf(bounds.From, PrecisionDay); bounds.To.After(day); day = day.AddDate(0, 0, 1) {
+		if slices.Contains(days, day.Weekday()) {
+			if part := bounds.Intersect(TimeRange{From: day, To: day.AddDate(0, 0, 1)}); !part.IsZero() {
+				ranges = append(ranges, part)
+			}
+		}
+	}
+	return ranges, nil
+}
+
+// holidaysIn returns the parts of bounds on holidays
+func (ht *Humantime) holidaysIn(bounds TimeRange) (RangeSet, error) {
+	if len(ht.holidays) == 0 {
+		return RangeSet{}, errors.New("no holidays configured, see WithHolidays")
+	}
+
+	var set RangeSet
+	for _, holiday := range ht.holidays {
+		var day = time.Date(holiday.Year(), holiday.Month(), holiday.Day(), 0, 0, 0, 0, ht.location)
+		set = set.Add(bounds.Intersect(TimeRange{From: day, To: day.AddDate(0, 0, 1)}))
+	}
+	return set, nil
+}
+
+// dailyWindow returns the parts of bounds between two times of day, a window
+// like "10pm to 2am" ends on the next day
+func (ht *Humantime) dailyWindow(bounds TimeRange, from, to string) (RangeSet, error) {
+	if !bounds.IsBounded() {
+		return RangeSet{}, errors.New("cannot expand times of day in an unbounded range")
+	}
+
+	var set RangeSet
+	// start a day early so a window crossing midnight into bounds is kept
+	for day := ht.startOf(bounds.From, PrecisionDay).AddDate(0, 0, -1); bounds.To.After(day); day = day.AddDate(0, 0, 1) {
+		var window, err = ht.window(day, from, to)
+		if err != nil {
+			return RangeSet{}, err
+		}
+		set = set.Add(bounds.Intersect(window))
+	}
+	return set, nil
+}
+
+// window returns the range between two times of day on day, it ends on the
+// next day when to is not after from
+func (ht *Humantime) window(day time.Time, from, to string) (TimeRange, error) {
+	start, err := ht.parseTimeString(day, from)
+	if err != nil {
+		return TimeRange{}, fmt.Errorf("error parsing time window %s to %s, err: %w", from, to, err)
+	}
+	end, err := ht.parseTimeString(day, to)
+	if err != nil {
+		return TimeRange{}, fmt.Errorf("error parsing time window %s to %s, err: %w", from, to, err)
+	}
+	if !end.After(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return TimeRange{From: start, To: end}, nil
+}
@@ -0,0 +1,90 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// march returns a time in March 2024 in UTC
+func march(day, hour int) time.Time {
+	return time.Date(2024, time.March, day, hour, 0, 0, 0, time.UTC)
+}
+
+// february returns midnight of a day in February 2024 in UTC
+func february(day int) time.Time {
+	return time.Date(2024, time.February, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseSet(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t, WithHolidays(time.Date(2024, time.February, 19, 0, 0, 0, 0, time.Local)))
+
+	var februaryWeekdays = []TimeRange{
+		{From: february(1), To: february(3)},
+		{From: february(5), To: february(10)},
+		{From: february(12), To: february(17)},
+		{From: february(19), To: february(24)},
+		{From: february(26), To: march(1, 0)},
+	}
+
+	var cases = map[string][]TimeRange{
+		"yesterday":                      {{From: march(5, 0), To: march(6, 0)}},
+		"since yesterday":                {{From: march(5, 0), To: now}},
+		"last month excluding weekends":  februaryWeekdays,
+		"weekdays last month":            februaryWeekdays,
+		"Last Month Except Weekends":     februaryWeekdays,
+		"this week except friday":        {{From: march(3, 0), To: march(8, 0)}, {From: march(9, 0), To: march(10, 0)}},
+		"this week except for fridays":   {{From: march(3, 0), To: march(8, 0)}, {From: march(9, 0), To: march(10, 0)}},
+		"this week except sat and sun":   {{From: march(4, 0), To: march(9, 0)}},
+		"this week except sat, sun":      {{From: march(4, 0), To: march(9, 0)}},
+		"this week except last monday":   {{From: march(3, 0), To: march(10, 0)}},
+		"this week except monday":        {{From: march(3, 0), To: march(4, 0)}, {From: march(5, 0), To: march(10, 0)}},
+		"yesterday except 12pm to 1pm":   {{From: march(5, 0), To: march(5, 12)}, {From: march(5, 13), To: march(6, 0)}},
+		"today except 10pm to 2am":       {{From: march(6, 2), To: march(6, 22)}},
+		"yesterday except 11:30 - 12:30": {{From: march(5, 0), To: march(5, 11).Add(30 * time.Minute)}, {From: march(5, 12).Add(30 * time.Minute), To: march(6, 0)}},
+		"since monday except 12pm to 1pm": {
+			{From: march(4, 0), To: march(4, 12)},
+			{From: march(4, 13), To: march(5, 12)},
+			{From: march(5, 13), To: now},
+		},
+		"weekdays last month but not holidays": {
+			{From: february(1), To: february(3)},
+			{From: february(5), To: february(10)},
+			{From: february(12), To: february(17)},
+			{From: february(20), To: february(24)},
+			{From: february(26), To: march(1, 0)},
+		},
+		"this week except weekends but not wednesday": {{From: march(4, 0), To: march(6, 0)}, {From: march(7, 0), To: march(9, 0)}},
+	}
+	for input, expected := range cases {
+		result, err := st.ParseSet(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result.Ranges(), input)
+	}
+
+	set, err := st.ParseSet("weekdays last month but not holidays")
+	assert.NoError(t, err)
+	assert.Equal(t, 20*24*time.Hour, set.Duration())
+
+	var errorCases = map[string]string{
+		"":                                "input cannot be empty",
+		"after friday except weekends":    "cannot expand days of the week in an unbounded range",
+		"before friday except 1pm to 2pm": "cannot expand times of day in an unbounded range",
+		"apples except weekends":          "could not parse apples",
+		"this week except apples":         "could not parse apples",
+		"this week except sat and apples": "could not parse apples",
+		"today except 1pm to 13pm":        "error parsing time window 1pm to 13pm, err: error parsing hour (13) in: 13pm, err: hour cannot be > 12",
+	}
+	for input, expected := range errorCases {
+		_, err := st.ParseSet(input)
+		assert.EqualError(t, err, expected, input)
+	}
+
+	// holidays must be configured
+	_, st = fixedNow(t)
+	_, err = st.ParseSet("last month except holidays")
+	assert.EqualError(t, err, "no holidays configured, see WithHolidays")
+}
@@ -3,7 +3,6 @@ package humantime
 import (
 	"fmt"
 	"strings"
-	"time"
 )
 
 // Since takes a string starting with the word since
@@ -16,7 +15,7 @@ import (
 // since yesterday at 13:34:32
 func (st *Humantime) Since(input string) (*TimeRange, error) {
 	var tr = new(TimeRange)
-	tr.To = time.Now().In(st.Location)
+	tr.To = st.now()
 
 	if len(strings.Fields(input)) < 2 {
 		return nil, fmt.Errorf("input must have at least two fields: %s", input)
@@ -26,6 +25,6 @@ func (st *Humantime) Since(input string) (*TimeRange, error) {
 	}
 
 	var err error
-	tr.From, err = st.parseDatePhrase(strings.ReplaceAll(input, "since ", ""))
+	tr.From, err = st.preferring(PreferPast).parseDatePhrase(strings.TrimPrefix(input, "since "))
 	return tr, err
 }
@@ -13,14 +13,16 @@ var TestSinceTestCases = map[string]time.Time{
 	"since yesterday":              time.Date(today.Year(), today.Month(), today.Day()-1, 0, 0, 0, 0, today.Location()),
 	"since yesterday at 4pm":       time.Date(today.Year(), today.Month(), today.Day()-1, 16, 0, 0, 0, today.Location()),
 	"since yesterday at 13:34:32":  time.Date(today.Year(), today.Month(), today.Day()-1, 13, 34, 32, 0, today.Location()),
-	"since 2am":                    time.Date(today.Year(), today.Month(), today.Day(), 02, 00, 00, 0, today.Location()),
+	"since 2020":                   time.Date(2020, time.January, 1, 0, 0, 0, 0, today.Location()),
+	"since 2024-03":                time.Date(2024, time.March, 1, 0, 0, 0, 0, today.Location()),
+	"since January 2025":           time.Date(2025, time.January, 1, 0, 0, 0, 0, today.Location()),
 }
 
 func TestSince(t *testing.T) {
 	t.Parallel()
 
 	var today = time.Now()
-	var st, err = NewString2Time(today.Location())
+	var st, err = New(WithLocation(today.Location()))
 	assert.NoError(t, err)
 
 	for input, expected := range TestSinceTestCases {
@@ -30,7 +32,16 @@ func TestSince(t *testing.T) {
 		assert.Equal(t, today.Round(time.Second), result.To.Round(time.Second))
 	}
 
-	result, err := st.Since("since")
+	// a bare time of day is resolved against the clock, 10:30 in fixedNow
+	var _, fixed = fixedNow(t)
+	result, err := fixed.Since("since 2am")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 6, 2, 0, 0, 0, time.UTC), result.From)
+	result, err = fixed.Since("since 11pm")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 5, 23, 0, 0, 0, time.UTC), result.From)
+
+	result, err = st.Since("since")
 	assert.Equal(t, "input must have at least two fields: since", err.Error())
 	assert.Nil(t, result)
 
@@ -0,0 +1,100 @@
+package humantime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// snapUnits are the short units of snapping phrases like "-1d@d" and "now-1h/h",
+// minutes are "m" and months "mo" like in compact durations since input is
+// lower cased, "mon" is kept as an alias
+var snapUnits = map[string]Precision{
+	"s":   PrecisionSecond,
+	"sec": PrecisionSecond,
+	"m":   PrecisionMinute,
+	"min": PrecisionMinute,
+	"h":   PrecisionHour,
+	"hr":  PrecisionHour,
+	"d":   PrecisionDay,
+	"w":   PrecisionWeek,
+	"mo":  PrecisionMonth,
+	"mon": PrecisionMonth,
+	"q":   PrecisionQuarter,
+	"y":   PrecisionYear,
+}
+
+// parsePrecision reads the name of a unit like "hour" or "week"
+func parsePrecision(name string) (Precision, bool) {
+	for p := PrecisionSecond; p <= PrecisionYear; p++ {
+		if p.String() == name {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
+// parseSnap reads phrases snapped to a unit: "-1d@d" is the start of
+// yesterday, "now-1h/h" the start of the previous hour, "3 hours ago on the
+// hour" the start of that hour and "now rounded to the hour" the nearest
+// hour. Snapped phrases are instants. found is false when the input is not snapped.
+func (ht *Humantime) parseSnap(input string) (date time.Time, precision Precision, found bool, err error) {
+	var lower = strings.ToLower(input)
+
+	if match := alignmentRegex.FindStringSubmatchIndex(lower); match != nil {
+		var unit, known = parsePrecision(lower[match[4]:match[5]])
+		if !known {
+			return time.Time{}, 0, true, fmt.Errorf("unknown unit: %s", lower[match[4]:match[5]])
+		}
+		if date, _, err = ht.parsePhrase(lower[:match[0]]); err != nil {
+			return time.Time{}, 0, true, err
+		}
+		if lower[match[2]:match[3]] == "on" {
+			return ht.startOf(date, unit), PrecisionSecond, true, nil
+		}
+		return ht.round(date, unit), PrecisionSecond, true, nil
+	}
+
+	// every unit has to be short, "now - 2 hours" is arithmetic
+	var match = snapRegex.FindStringSubmatch(strings.Join(strings.Fields(lower), ""))
+	if match == nil || match[2] == "" && match[3] == "" {
+		return time.Time{}, 0, false, nil
+	}
+	var ops = snapOffsetRegex.FindAllStringSubmatch(match[2], -1)
+	for _, op := range ops {
+		if _, known := snapUnits[op[3]]; !known {
+			return time.Time{}, 0, false, nil
+		}
+	}
+	var snapTo, snapped = snapUnits[match[3]]
+	if match[3] != "" && !snapped {
+		return time.Time{}, 0, false, nil
+	}
+
+	date = ht.now()
+	for _, op := range ops {
+		var num, err = strconv.Atoi(op[2])
+		if err != nil {
+			return time.Time{}, 0, true, fmt.Errorf("error parsing number: %s, err: %w", op[2], err)
+		}
+		if op[1] == "-" {
+			date = snapUnits[op[3]].length().times(num).SubFrom(date)
+		} else {
+			date = snapUnits[op[3]].length().times(num).AddTo(date)
+		}
+	}
+	if snapped {
+		date = ht.startOf(date, snapTo)
+	}
+	return date, PrecisionSecond, true, nil
+}
+
+// round returns the start of the unit nearest to t, halfway rounds up
+func (ht *Humantime) round(t time.Time, unit Precision) time.Time {
+	var start, end = ht.startOf(t, unit), ht.endOf(t, unit)
+	if t.Sub(start) < end.Sub(t) {
+		return start
+	}
+	return end
+}
@@ -0,0 +1,58 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSnap(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var cases = map[string]time.Time{
+		"-1d@d":                           time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC),
+		"now-1h/h":                        time.Date(2024, time.March, 6, 9, 0, 0, 0, time.UTC),
+		"now/d":                           time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC),
+		"@w":                              time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC),
+		"-1mo@mo":                         time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		"-1mon@mon":                       time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		"now/mo":                          time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		"-2d-30m":                         time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC),
+		"now+1q/q":                        time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+		"now - 1h / h":                    time.Date(2024, time.March, 6, 9, 0, 0, 0, time.UTC),
+		"-1y@y":                           time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"3 hours ago on the hour":         time.Date(2024, time.March, 6, 7, 0, 0, 0, time.UTC),
+		"3 hours ago rounded to the hour": time.Date(2024, time.March, 6, 8, 0, 0, 0, time.UTC),
+		"now rounded to the nearest day":  time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC),
+		"yesterday at 3:20pm on the hour": time.Date(2024, time.March, 5, 15, 0, 0, 0, time.UTC),
+		"next friday on the week":         time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		result, err := st.ParseTime(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, result, input)
+	}
+
+	// ranges from snapped phrases
+	result, err := st.Parse("since 3 hours ago on the hour")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: time.Date(2024, time.March, 6, 7, 0, 0, 0, time.UTC), To: now}, *result)
+	result, err = st.Parse("from -7d@d to @d")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC)}, *result)
+
+	// weeks honor the week start
+	var _, monday = fixedNow(t, WithWeekStart(time.Monday))
+	date, err := monday.ParseTime("now/w")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), date)
+
+	// errors
+	_, err = st.ParseTime("now on the fortnight")
+	assert.Equal(t, "unknown unit: fortnight", err.Error())
+	_, err = st.ParseTime("-1x@d")
+	assert.Error(t, err)
+}
@@ -0,0 +1,209 @@
+package humantime
+
+import "time"
+
+// IsBounded reports whether the range has both a start and an end
+func (v TimeRange) IsBounded() bool {
+	return !v.FromUnbounded && !v.ToUnbounded
+}
+
+// Bounded returns a copy of the range with its open ends set to now, the
+// way Before and After used to build ranges: "before friday" becomes
+// now until friday and "after monday" monday until now
+func (v TimeRange) Bounded(now time.Time) TimeRange {
+	if v.FromUnbounded {
+		v.From, v.FromUnbounded = now, false
+	}
+	if v.ToUnbounded {
+		v.To, v.ToUnbounded = now, false
+	}
+	return v
+}
+
+// maxDuration is the Duration of an unbounded range
+const maxDuration = time.Duration(1<<63 - 1)
+
+// Contains reports whether t is in the range
+func (v TimeRange) Contains(t time.Time) bool {
+	var afterFrom = v.FromUnbounded || t.After(v.From) || (!v.FromExclusive && t.Equal(v.From))
+	var beforeTo = v.ToUnbounded || t.Before(v.To) || (v.ToInclusive && t.Equal(v.To))
+	return afterFrom && beforeTo
+}
+
+// IsZero reports whether the range contains no instant, like the zero
+// TimeRange or "from friday to monday" when friday is after monday
+func (v TimeRange) IsZero() bool {
+	switch {
+	case v.FromUnbounded || v.ToUnbounded:
+		return false
+	case v.From.Equal(v.To):
+		return v.FromExclusive || !v.ToInclusive
+	}
+	return v.From.After(v.To)
+}
+
+// Equal reports whether both ranges contain the same instants, locations do
+// not matter and all empty ranges are equal
+func (v TimeRange) Equal(r TimeRange) bool {
+	if v.IsZero() || r.IsZero() {
+		return v.IsZero() && r.IsZero()
+	}
+	return v.FromUnbounded == r.FromUnbounded && v.ToUnbounded == r.ToUnbounded &&
+		(v.FromUnbounded || (v.From.Equal(r.From) && v.FromExclusive == r.FromExclusive)) &&
+		(v.ToUnbounded || (v.To.Equal(r.To) && v.ToInclusive == r.ToInclusive))
+}
+
+// Duration returns the length of the range, an unbounded range is as long as
+// time.Duration allows and an empty range is 0
+func (v TimeRange) Duration() time.Duration {
+	switch {
+	case v.IsZero():
+		return 0
+	case !v.IsBounded():
+		return maxDuration
+	}
+	return v.To.Sub(v.From)
+}
+
+// Overlaps reports whether the ranges have an instant in common
+func (v TimeRange) Overlaps(r TimeRange) bool {
+	return !v.Intersect(r).IsZero()
+}
+
+// Intersect returns the instants in both ranges, the zero TimeRange when
+// there are none
+func (v TimeRange) Intersect(r TimeRange) TimeRange {
+	var result = v
+	if startsBefore(v, r) {
+		result.From, result.FromUnbounded, result.FromExclusive = r.From, r.FromUnbounded, r.FromExclusive
+	}
+	if endsAfter(v, r) {
+		result.To, result.ToUnbounded, result.ToInclusive = r.To, r.ToUnbounded, r.ToInclusive
+	}
+	if result.IsZero() {
+		return TimeRange{}
+	}
+	return result
+}
+
+// Union returns the range covering both ranges, ok is false when there is
+// a gap between them and no single range can, see RangeSet for that
+func (v TimeRange) Union(r TimeRange) (union TimeRange, ok bool) {
+	switch {
+	case v.IsZero():
+		return r, true
+	case r.IsZero():
+		return v, true
+	case gapBetween(v, r) || gapBetween(r, v):
+		return TimeRange{}, false
+	}
+
+	var result = v
+	if startsBefore(r, v) {
+		result.From, result.FromUnbounded, result.FromExclusive = r.From, r.FromUnbounded, r.FromExclusive
+	}
+	if endsAfter(r, v) {
+		result.To, result.ToUnbounded, result.ToInclusive = r.To, r.ToUnbounded, r.ToInclusive
+	}
+	return result, true
+}
+
+// Clamp limits the range to bounds, e.g. an "after friday" range to now with
+// TimeRange{FromUnbounded: true, To: now}. It is the zero TimeRange when the
+// range is entirely outside bounds.
+func (v TimeRange) Clamp(bounds TimeRange) TimeRange {
+	return v.Intersect(bounds)
+}
+
+// Shift moves both ends of the range by period, use period.Neg() to move it back
+func (v TimeRange) Shift(period Duration) TimeRange {
+	if !v.FromUnbounded {
+		v.From = period.AddTo(v.From)
+	}
+	if !v.ToUnbounded {
+		v.To = period.AddTo(v.To)
+	}
+	return v
+}
+
+// Extend moves the start of the range back by before and the end forward by after
+func (v TimeRange) Extend(before, after Duration) TimeRange {
+	if !v.FromUnbounded {
+		v.From = before.SubFrom(v.From)
+	}
+	if !v.ToUnbounded {
+		v.To = after.AddTo(v.To)
+	}
+	return v
+}
+
+// Truncate snaps both ends of the range down to the start of their unit, e.g.
+// the hour or the week. The unit is on the calendar of st: its location, week
+// start and fiscal year start. A nil st uses the location of the range with
+// weeks starting on Sunday and years in January.
+func (v TimeRange) Truncate(unit Precision, st *Humantime) TimeRange {
+	var ht = calendarOf(st, v)
+	if !v.FromUnbounded {
+		v.From = ht.startOf(v.From, unit)
+	}
+	if !v.ToUnbounded {
+		v.To = ht.startOf(v.To, unit)
+	}
+	return v
+}
+
+// Round snaps both ends of the range to the nearest start of their unit,
+// halfway rounds up. The calendar is the same as Truncate's.
+func (v TimeRange) Round(unit Precision, st *Humantime) TimeRange {
+	var ht = calendarOf(st, v)
+	if !v.FromUnbounded {
+		v.From = ht.round(v.From, unit)
+	}
+	if !v.ToUnbounded {
+		v.To = ht.round(v.To, unit)
+	}
+	return v
+}
+
+// calendarOf returns st, or when it is nil a default calendar in the location of the range
+func calendarOf(st *Humantime, v TimeRange) *Humantime {
+	if st != nil {
+		return st
+	}
+	var loc = v.From.Location()
+	if v.FromUnbounded {
+		loc = v.To.Location()
+	}
+	return &Humantime{location: loc, weekStart: time.Sunday, fiscalYearStart: time.January}
+}
+
+// startsBefore reports whether a starts before b
+func startsBefore(a, b TimeRange) bool {
+	switch {
+	case a.FromUnbounded || b.FromUnbounded:
+		return a.FromUnbounded && !b.FromUnbounded
+	case !a.From.Equal(b.From):
+		return a.From.Before(b.From)
+	}
+	return !a.FromExclusive && b.FromExclusive
+}
+
+// endsAfter reports whether a ends after b
+func endsAfter(a, b TimeRange) bool {
+	switch {
+	case a.ToUnbounded || b.ToUnbounded:
+		return a.ToUnbounded && !b.ToUnbounded
+	case !a.To.Equal(b.To):
+		return a.To.After(b.To)
+	}
+	return a.ToInclusive && !b.ToInclusive
+}
+
+// gapBetween reports whether a ends before b starts with at least one
+// instant in between, [1, 2) and [2, 3) touch and have no gap
+func gapBetween(a, b TimeRange) bool {
+	if a.ToUnbounded || b.FromUnbounded {
+		return false
+	}
+	return a.To.Before(b.From) || (a.To.Equal(b.From) && !a.ToInclusive && b.FromExclusive)
+}
@@ -0,0 +1,203 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBounded(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	before, err := st.Parse("before 2020-01-01")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{To: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), FromUnbounded: true}, *before)
+	assert.False(t, before.IsBounded())
+	assert.Equal(t, TimeRange{From: now, To: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)}, before.Bounded(now))
+
+	after, err := st.Parse("after yesterday")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC), ToUnbounded: true}, *after)
+	assert.Equal(t, "From: Wed, 06 Mar 2024 00:00:00 UTC, To: unbounded", after.String())
+	assert.Equal(t, TimeRange{From: time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC), To: now}, after.Bounded(now))
+	assert.True(t, after.Bounded(now).IsBounded())
+
+	// since and until stay bounded by now
+	since, err := st.Parse("since yesterday")
+	assert.NoError(t, err)
+	assert.True(t, since.IsBounded())
+	assert.Equal(t, *since, since.Bounded(now.Add(time.Hour)))
+}
+
+// at returns a time on March 6th 2024 in UTC
+func at(hour int) time.Time {
+	return time.Date(2024, time.March, 6, hour, 0, 0, 0, time.UTC)
+}
+
+func TestContains(t *testing.T) {
+	t.Parallel()
+
+	var r = TimeRange{From: at(9), To: at(17)}
+	assert.True(t, r.Contains(at(9)))
+	assert.True(t, r.Contains(at(12)))
+	assert.False(t, r.Contains(at(17)))
+	assert.False(t, r.Contains(at(8)))
+
+	r.FromExclusive, r.ToInclusive = true, true
+	assert.False(t, r.Contains(at(9)))
+	assert.True(t, r.Contains(at(17)))
+
+	assert.True(t, TimeRange{To: at(9), FromUnbounded: true}.Contains(at(0).AddDate(-100, 0, 0)))
+	assert.True(t, TimeRange{From: at(9), ToUnbounded: true}.Contains(at(0).AddDate(100, 0, 0)))
+	assert.False(t, TimeRange{}.Contains(time.Time{}))
+}
+
+func TestIsZeroAndEqual(t *testing.T) {
+	t.Parallel()
+
+	var cases = map[string]struct {
+		r    TimeRange
+		zero bool
+	}{
+		"zero value":      {TimeRange{}, true},
+		"backwards":       {TimeRange{From: at(17), To: at(9)}, true},
+		"single instant":  {TimeRange{From: at(9), To: at(9), ToInclusive: true}, false},
+		"half open point": {TimeRange{From: at(9), To: at(9)}, true},
+		"open point":      {TimeRange{From: at(9), To: at(9), FromExclusive: true, ToInclusive: true}, true},
+		"unbounded":       {TimeRange{FromUnbounded: true, ToUnbounded: true}, false},
+		"before":          {TimeRange{To: at(9), FromUnbounded: true}, false},
+	}
+	for name, c := range cases {
+		assert.Equal(t, c.zero, c.r.IsZero(), name)
+	}
+
+	var denver, err = time.LoadLocation("America/Denver")
+	assert.NoError(t, err)
+	var r = TimeRange{From: at(9), To: at(17)}
+	assert.True(t, r.Equal(TimeRange{From: at(9).In(denver), To: at(17).In(denver)}))
+	assert.False(t, r.Equal(TimeRange{From: at(9), To: at(17), ToInclusive: true}))
+	assert.False(t, r.Equal(TimeRange{From: at(9), ToUnbounded: true}))
+	assert.True(t, TimeRange{From: at(17), To: at(9)}.Equal(TimeRange{}))
+	assert.True(t, TimeRange{To: at(9), FromUnbounded: true}.Equal(TimeRange{From: at(1), To: at(9), FromUnbounded: true}))
+}
+
+func TestDurationOfRange(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 8*time.Hour, TimeRange{From: at(9), To: at(17)}.Duration())
+	assert.Equal(t, time.Duration(0), TimeRange{From: at(17), To: at(9)}.Duration())
+	assert.Equal(t, maxDuration, TimeRange{From: at(9), ToUnbounded: true}.Duration())
+}
+
+func TestIntersect(t *testing.T) {
+	t.Parallel()
+
+	var work = TimeRange{From: at(9), To: at(17)}
+	var cases = map[string]struct {
+		r        TimeRange
+		expected TimeRange
+	}{
+		"inside":       {TimeRange{From: at(10), To: at(11)}, TimeRange{From: at(10), To: at(11)}},
+		"overlapping":  {TimeRange{From: at(15), To: at(20)}, TimeRange{From: at(15), To: at(17)}},
+		"after":        {TimeRange{From: at(12), ToUnbounded: true, FromExclusive: true}, TimeRange{From: at(12), To: at(17), FromExclusive: true}},
+		"before":       {TimeRange{To: at(12), FromUnbounded: true, ToInclusive: true}, TimeRange{From: at(9), To: at(12), ToInclusive: true}},
+		"touching":     {TimeRange{From: at(17), To: at(20)}, TimeRange{}},
+		"disjoint":     {TimeRange{From: at(18), To: at(20)}, TimeRange{}},
+		"same start":   {TimeRange{From: at(9), To: at(10), FromExclusive: true}, TimeRange{From: at(9), To: at(10), FromExclusive: true}},
+		"unbounded":    {TimeRange{FromUnbounded: true, ToUnbounded: true}, work},
+		"inclusive to": {TimeRange{From: at(17), To: at(20), ToInclusive: true}, TimeRange{}},
+	}
+	for name, c := range cases {
+		assert.Equal(t, c.expected, work.Intersect(c.r), name)
+		assert.Equal(t, c.expected, c.r.Intersect(work), name)
+		assert.Equal(t, !c.expected.IsZero(), work.Overlaps(c.r), name)
+	}
+
+	// an inclusive end touching an inclusive start shares one instant
+	var closed = TimeRange{From: at(9), To: at(17), ToInclusive: true}
+	assert.True(t, closed.Overlaps(TimeRange{From: at(17), To: at(20)}))
+
+	// clamping an open range to now
+	var after = TimeRange{From: at(9), ToUnbounded: true}
+	assert.Equal(t, TimeRange{From: at(9), To: at(12)}, after.Clamp(TimeRange{FromUnbounded: true, To: at(12)}))
+	assert.Equal(t, TimeRange{}, after.Clamp(TimeRange{From: at(1), To: at(2)}))
+}
+
+func TestUnion(t *testing.T) {
+	t.Parallel()
+
+	var work = TimeRange{From: at(9), To: at(17)}
+	var cases = map[string]struct {
+		r        TimeRange
+		expected TimeRange
+		ok       bool
+	}{
+		"overlapping": {TimeRange{From: at(15), To: at(20)}, TimeRange{From: at(9), To: at(20)}, true},
+		"touching":    {TimeRange{From: at(17), To: at(20)}, TimeRange{From: at(9), To: at(20)}, true},
+		"open point":  {TimeRange{From: at(17), To: at(20), FromExclusive: true}, TimeRange{}, false},
+		"disjoint":    {TimeRange{From: at(18), To: at(20)}, TimeRange{}, false},
+		"empty":       {TimeRange{}, work, true},
+		"after":       {TimeRange{From: at(12), ToUnbounded: true}, TimeRange{From: at(9), ToUnbounded: true}, true},
+	}
+	for name, c := range cases {
+		result, ok := work.Union(c.r)
+		assert.Equal(t, c.ok, ok, name)
+		assert.True(t, c.expected.Equal(result), name)
+		result, ok = c.r.Union(work)
+		assert.Equal(t, c.ok, ok, name)
+		assert.True(t, c.expected.Equal(result), name)
+	}
+}
+
+func TestShiftAndExtend(t *testing.T) {
+	t.Parallel()
+
+	var jan31 = TimeRange{
+		From: time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+	}
+	assert.Equal(t, TimeRange{
+		From: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+	}, jan31.Shift(Duration{Months: 1}))
+	assert.Equal(t, TimeRange{
+		From: time.Date(2024, time.January, 30, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC),
+	}, jan31.Shift(Duration{Days: 1}.Neg()))
+
+	var after = TimeRange{From: at(9), ToUnbounded: true, FromExclusive: true}
+	assert.Equal(t, TimeRange{From: at(10), ToUnbounded: true, FromExclusive: true}, after.Shift(Duration{Clock: time.Hour}))
+	assert.Equal(t, TimeRange{From: at(8), ToUnbounded: true, FromExclusive: true}, after.Extend(Duration{Clock: time.Hour}, Duration{Days: 1}))
+	assert.Equal(t, TimeRange{From: at(8), To: at(18)}, TimeRange{From: at(9), To: at(17)}.Extend(Duration{Clock: time.Hour}, Duration{Clock: time.Hour}))
+}
+
+func TestTruncateAndRound(t *testing.T) {
+	t.Parallel()
+
+	var r = TimeRange{From: time.Date(2024, time.March, 6, 9, 40, 10, 0, time.UTC), To: time.Date(2024, time.March, 6, 17, 20, 0, 0, time.UTC)}
+	assert.Equal(t, TimeRange{From: at(9), To: at(17)}, r.Truncate(PrecisionHour, nil))
+	assert.Equal(t, TimeRange{From: at(10), To: at(17)}, r.Round(PrecisionHour, nil))
+	assert.Equal(t, TimeRange{From: at(0), To: at(0)}, r.Truncate(PrecisionDay, nil))
+	assert.Equal(t, TimeRange{From: at(0), To: at(24)}, r.Round(PrecisionDay, nil))
+
+	// open ends and flags are kept
+	var after = TimeRange{From: r.From, ToUnbounded: true, FromExclusive: true}
+	assert.Equal(t, TimeRange{From: at(9), ToUnbounded: true, FromExclusive: true}, after.Truncate(PrecisionHour, nil))
+
+	// weeks start on the week start of the Humantime, in its location
+	monday, err := New(WithLocation(time.UTC), WithWeekStart(time.Monday))
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC)}, r.Truncate(PrecisionWeek, nil))
+	assert.Equal(t, TimeRange{From: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)}, r.Truncate(PrecisionWeek, monday))
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	st, err := New(WithLocation(tokyo))
+	assert.NoError(t, err)
+	var truncated = r.Truncate(PrecisionDay, st)
+	assert.True(t, time.Date(2024, time.March, 6, 0, 0, 0, 0, tokyo).Equal(truncated.From))
+	assert.True(t, time.Date(2024, time.March, 7, 0, 0, 0, 0, tokyo).Equal(truncated.To))
+}
@@ -0,0 +1,43 @@
+package humantime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// toDatePeriods maps the to-date phrases to the period they start at
+var toDatePeriods = map[string]Precision{
+	"ytd":             PrecisionYear,
+	"year to date":    PrecisionYear,
+	"qtd":             PrecisionQuarter,
+	"quarter to date": PrecisionQuarter,
+	"mtd":             PrecisionMonth,
+	"month to date":   PrecisionMonth,
+	"wtd":             PrecisionWeek,
+	"week to date":    PrecisionWeek,
+}
+
+// ToDate takes a to-date period and returns the range from its start to now,
+// years and quarters follow the fiscal year start and weeks the week start, examples:
+// YTD
+// quarter to date
+// MTD
+// week to date
+func (st *Humantime) ToDate(input string) (*TimeRange, error) {
+	var period, found = toDatePeriod(input)
+	if !found {
+		return nil, fmt.Errorf("input must be YTD, QTD, MTD, WTD or the same spelled out: %s", input)
+	}
+	return st.toDate(period), nil
+}
+
+// toDatePeriod looks up a to-date phrase ignoring case and extra spaces
+func toDatePeriod(input string) (Precision, bool) {
+	var period, found = toDatePeriods[strings.Join(strings.Fields(strings.ToLower(input)), " ")]
+	return period, found
+}
+
+func (ht *Humantime) toDate(period Precision) *TimeRange {
+	var now = ht.now()
+	return &TimeRange{From: ht.startOf(now, period), To: now}
+}
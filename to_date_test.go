@@ -0,0 +1,48 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToDate(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var cases = map[string]time.Time{
+		"YTD":             time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"year  to date":   time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"qtd":             time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"MTD":             time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		"month  to date":  time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		"WTD":             time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC),
+		"week to date":    time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC),
+		"quarter to date": time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		result, err := st.ToDate(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, TimeRange{From: expected, To: now}, *result, input)
+
+		// Parse reads them the same
+		result, err = st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, TimeRange{From: expected, To: now}, *result, input)
+	}
+
+	result, err := st.ToDate("day to date")
+	assert.Equal(t, "input must be YTD, QTD, MTD, WTD or the same spelled out: day to date", err.Error())
+	assert.Nil(t, result)
+
+	// the fiscal year and week start move the start
+	_, st = fixedNow(t, WithFiscalYearStart(time.July), WithWeekStart(time.Monday))
+	result, err = st.ToDate("YTD")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC), To: now}, *result)
+	result, err = st.ToDate("WTD")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), To: now}, *result)
+}
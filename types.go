@@ -5,73 +5,246 @@ import (
 	"time"
 )
 
-// Humantime facilitates converting time in English words to a time.Time type
+// Humantime facilitates converting time in English words to a time.Time type.
+// It is safe to share across goroutines: its settings cannot change once built
+// by New and its vocabulary is replaced atomically.
 type Humantime struct {
-	*time.Location
-	AMOrPMRegex    *regexp.Regexp
-	ExactTimeRegex *regexp.Regexp
-	SynonymRegex   *regexp.Regexp
-	AtTimeRegex    *regexp.Regexp
-	WeekdayRegex   *regexp.Regexp
+	location *time.Location
+	clock    func() time.Time
+
+	// weekStart is the first day of the week for "this/last/next [weekday]"
+	weekStart time.Weekday
+
+	// fiscalYearStart is the first month of the year and its quarters for
+	// "this year", "last quarter" and "YTD"
+	fiscalYearStart time.Month
+
+	// language of the input, only English is supported
+	language string
+
+	// prefer decides which occurrence a bare weekday or time like "friday" or
+	// "3pm" resolves to, it overrides the default of each keyword
+	prefer Prefer
+
+	// dateOrder is how numeric dates like 03/04/2024 are read, year first
+	// dates like 2024-03-04 are always read as YMD
+	dateOrder DateOrder
+
+	// strict rejects numeric dates that could be read as two different days
+	// e.g. 03/04/2024, instead of reading them in dateOrder
+	strict bool
+
+	// twoDigitYearPivot decides the century of two digit years like 3/15/22:
+	// years below the pivot are in the 2000s, the rest in the 1900s
+	twoDigitYearPivot int
+
+	// bareEpoch is how numbers without '@' or a unit like 1700000000 are read
+	bareEpoch EpochUnit
+
+	// absoluteParser reads absolute dates like "May 8, 2009 5:57:51 PM"
+	absoluteParser AbsoluteParser
+
+	// layouts are custom Go time layouts tried before absoluteParser
+	layouts []string
+
+	// holidays are the days "holidays" means in ParseSet, only their dates are used
+	holidays []time.Time
+
+	// vars are bound for a single call by ParseWithVars, names are lower case
+	vars map[string]time.Time
+
+	// vocab is the only thing that can change after New, it is swapped
+	// atomically by the Add methods
+	vocab *vocabHolder
 }
 
-// TimeRange is the return type of this package
+// DateOrder is the order of the day, month and year in numeric dates
+type DateOrder int
+
+const (
+	// MDY is month/day/year as in the US e.g. 3/15/2022
+	MDY DateOrder = iota
+	// DMY is day/month/year as in most of Europe e.g. 15/3/2022
+	DMY
+	// YMD is year/month/day e.g. 2022/3/15
+	YMD
+)
+
+// Prefer decides which occurrence an ambiguous phrase resolves to when it
+// does not say whether it means the past or the future, e.g. "friday"
+type Prefer int
+
+const (
+	// PreferDefault lets each keyword decide: since and after prefer the past,
+	// until and before prefer the future. Without a keyword bare times stay on
+	// the current day and bare weekdays resolve to the past.
+	PreferDefault Prefer = iota
+	// PreferPast resolves to the most recent occurrence, today included
+	PreferPast
+	// PreferFuture resolves to the next occurrence, today included
+	PreferFuture
+	// PreferNearest resolves to whichever occurrence is closest to now
+	PreferNearest
+)
+
+// EpochUnit is how bare numbers like 1700000000 are read as Unix epoch
+// times, numbers with '@' or a unit like "@1700000000" or "1700000000123ms"
+// are always epochs
+type EpochUnit int
+
+const (
+	// EpochByDigits reads 10 digits as seconds, 13 as milliseconds, 16 as
+	// microseconds and 19 as nanoseconds, other numbers are not epochs
+	EpochByDigits EpochUnit = iota
+	// EpochSeconds reads every bare number as seconds
+	EpochSeconds
+	// EpochMilliseconds reads every bare number as milliseconds
+	EpochMilliseconds
+	// EpochMicroseconds reads every bare number as microseconds
+	EpochMicroseconds
+	// EpochNanoseconds reads every bare number as nanoseconds
+	EpochNanoseconds
+	// EpochNone never reads bare numbers as epochs
+	EpochNone
+)
+
+// Precision is how exact a date phrase is: "friday" is a whole day, "next
+// month" a whole month and "friday at 3:30pm" a minute. Range keywords use it
+// to cover the whole period, "until friday" ends when friday does.
+type Precision int
+
+const (
+	// PrecisionSecond is an exact instant like "now" or "3:30:15pm"
+	PrecisionSecond Precision = iota
+	// PrecisionMinute is a time like "3:30pm"
+	PrecisionMinute
+	// PrecisionHour is a time like "3pm"
+	PrecisionHour
+	// PrecisionDay is a day like "friday" or "3/15/2022"
+	PrecisionDay
+	// PrecisionWeek is a week like "next week"
+	PrecisionWeek
+	// PrecisionMonth is a month like "last month"
+	PrecisionMonth
+	// PrecisionQuarter is a quarter like "this quarter"
+	PrecisionQuarter
+	// PrecisionYear is a year like "next year"
+	PrecisionYear
+)
+
+// TimeRange is the return type of this package. A range can be open ended,
+// "before X" has no start and "after X" has no end: the missing bound is the
+// zero time and FromUnbounded or ToUnbounded is set.
+// Ranges are half open by default, From is included and To is not.
 type TimeRange struct {
 	From time.Time
 	To   time.Time
+
+	FromUnbounded bool
+	ToUnbounded   bool
+
+	// FromExclusive is set when From itself is not in the range, as in "after 3pm"
+	FromExclusive bool
+	// ToInclusive is set when To itself is in the range
+	ToInclusive bool
 }
 
 // all text is passed through strings.ToLower() before these regexs are evaluated
-const exactTime = `\d{1,2}:\d{1,2}(:\d{1,2})?`                                                // one or two digits, ':', one or two digits, optional: [':' one or two digits]
-const amORpm = `(\d{1,2}am)|(\d{1,2}pm)`                                                      // one or two digits, followed by 'am' OR [same for pm]
-const synonyms = `(yesterday|today|tomorrow)`                                                 // any of these three words
-const atTime = `(at)?\s*(\d{1,2}am)|(at)?\s*(\d{1,2}pm)|(at)?\s*(\d{1,2}:\d{1,2}(:\d{1,2})?)` // [optional 'at'], any amout of spcace, one or two digits, 'am' OR [same for pm] OR [similar for 00:11:22]
-const weekdays = `(next|last|this)\s*((mon|tues|wed(nes)?|thur(s)?|fri|sat(ur)?|sun)(day)?)`  // any of these three words, any amount of space, any day of the week with optional abbreviation
-
-// DurationWords turns word durations into time.Duration
-var DurationWords = map[string]time.Duration{
-	"second":  time.Second,
-	"seconds": time.Second,
-	"minute":  time.Minute,
-	"minutes": time.Minute,
-	"hour":    time.Hour,
-	"hours":   time.Hour,
-	"day":     time.Hour * 24,
-	"days":    time.Hour * 24,
-	"week":    time.Hour * 24 * 7,
-	"weeks":   time.Hour * 24 * 7,
-	"month":   time.Hour * 24 * 7 * 30,
-	"months":  time.Hour * 24 * 7 * 30,
-	"year":    time.Second * 31536000,
-	"years":   time.Second * 31536000,
-}
+const exactTime = `\d{1,2}:\d{1,2}(:\d{1,2})?`                                                            // one or two digits, ':', one or two digits, optional: [':' one or two digits]
+const amORpm = `(\d{1,2})(?::(\d{1,2})(?::(\d{1,2}))?)?\s*(am|pm)\b`                                      // one or two digits, optional: [':' one or two digits, optional: [':' one or two digits]], any amount of space, 'am' or 'pm'
+const atTime = `(at)?\s*(\d{1,2}(:\d{1,2}(:\d{1,2})?)?\s*(am|pm)\b)|(at)?\s*(\d{1,2}:\d{1,2}(:\d{1,2})?)` // [optional 'at'], any amout of spcace, [same as amORpm] OR [similar for 00:11:22]
+const numericDate = `\b(\d{1,4})[/.-](\d{1,2})[/.-](\d{1,4})\b`                                           // three groups of digits separated by '/', '.' or '-'
+const offset = `\s(before|after|from)\s`                                                                  // 'before', 'after' or 'from' between spaces, as in "2 days before [date phrase]"
+const period = `\b(this|next|last)\s+(day|week|month|quarter|year)s?\b`                                   // 'this', 'next' or 'last' followed by a calendar period
+const arithmetic = `\s+([+-])\s+`                                                                         // '+' or '-' between spaces, as in "$start - 15 minutes"
+const variable = `\$([a-z_][a-z0-9_]*)`                                                                   // '$' followed by a name, as in "$deploy"
+const weekdayModifiers = `next|last|this|coming|upcoming|previous|past|on`                                // words that may precede a weekday
+const exclusion = `\s+(?:except(?:\s+for)?|excluding|but\s+not)\s+`                                       // words that start an exclusion, as in "last week except weekends"
+const listSeparator = `\s*,\s*(?:and\s+)?|\s+and\s+`                                                      // commas and 'and' between items of a list, as in "friday, saturday and sunday"
+const timeWindow = `^(.+?)\s+(?:to|-)\s+(.+)$`                                                            // two time phrases, as in "12pm to 1pm"
 
-// TimeSynonyms maps relative time words to time.Time based on the current wall time
-var TimeSynonyms = map[string]func(*time.Location) time.Time{
-	"yesterday": func(loc *time.Location) time.Time {
-		var now = time.Now().Add(time.Hour * -24)
-		var y, m, d = now.Date()
-		return time.Date(y, m, d, 0, 0, 0, 0, loc)
-	},
-	"today": func(loc *time.Location) time.Time {
-		var now = time.Now()
-		var y, m, d = now.Date()
-		return time.Date(y, m, d, 0, 0, 0, 0, loc)
-	},
-	"tomorrow": func(loc *time.Location) time.Time {
-		var now = time.Now().Add(time.Hour * 24)
-		var y, m, d = now.Date()
-		return time.Date(y, m, d, 0, 0, 0, 0, loc)
-	},
-}
+// a time with am/pm or a colon, as in "9am" or "17:30"
+const timeOfDay = `\d{1,2}(?::\d{1,2}){0,2}\s*(?:am|pm)\b|\d{1,2}:\d{1,2}(?::\d{1,2})?`
 
-// StringToWeekdays maps words to their time.Weekday counterparts
-var StringToWeekdays = map[string]time.Weekday{
-	"monday":    time.Monday,
-	"tuesday":   time.Tuesday,
-	"webnesday": time.Wednesday,
-	"thursday":  time.Thursday,
-	"friday":    time.Friday,
-	"saturday":  time.Saturday,
-	"sunday":    time.Sunday,
-}
+// two times of day, as in "between 9am and 5pm", "from 9am to 5pm" or "2pm-4pm"
+const timesBetween = `\bbetween\s+(` + timeOfDay + `)\s+and\s+(` + timeOfDay + `)|(?:\b(?:at|from)\s+)?(` + timeOfDay + `)\s*(?:-|to)\s*(` + timeOfDay + `)`
+
+// a number of days of the week, as in "the last 5 fridays"
+const countedDays = `^(?:the\s+)?(last|past|previous|next|coming|upcoming)\s+(\d+)\s+(\S+)$`
+
+// 'through' or 'thru' between the ends of a range, as in "monday through friday"
+const through = `\s+(?:through|thru)\s+`
+
+// a dash or '..' between the ends of a range, as in "mar 3 – mar 5" or "2024-03-03..2024-03-05"
+const spanDash = `\s*(?:\.\.|–|—)\s*|\s+-\s+`
+
+// two times of day joined by a dash, as in "9am-5pm" or "9-11am"
+const timeSpan = `^(` + timeOfDay + `|\d{1,2})\s*-\s*(` + timeOfDay + `)$`
+
+// a rolling window of time, as in "past 24 hours" or "the next 3 weeks"
+const window = `^(?:the\s+)?(past|last|previous|next|coming)\s+(.+)$`
+
+// the start or end of a period, as in "end of last month", "EOD friday" or "close of business"
+const boundary = `^(start\s+of|beginning\s+of|end\s+of|eod|cob|close\s+of\s+business)\b\s*(?:the\s+)?(.*)$`
+
+// an offset from now snapped to a unit, as in "-1d@d" or "now-1h/h", spaces are removed first
+const snap = `^(now)?((?:[+-]\d+[a-z]+)*)(?:[@/]([a-z]+))?$`
+
+// one offset of snap, as in "-1d"
+const snapOffset = `([+-])(\d+)([a-z]+)`
+
+// a date phrase aligned to a unit, as in "3 hours ago on the hour" or "now rounded to the nearest day"
+const alignment = `\s+(on|rounded\s+to)\s+the\s+(?:nearest\s+)?([a-z]+)$`
+
+// an ISO 8601 duration, as in "P1Y2M3DT4H" or "PT90M", input is upper cased first
+const isoDuration = `^([+-])?P(?:(-?\d+)Y)?(?:(-?\d+)M)?(?:(-?\d+)W)?(?:(-?\d+)D)?(?:T(?:(-?\d+)H)?(?:(-?\d+)M)?(?:(-?\d+(?:[.,]\d+)?)S)?)?$`
+
+// the repetitions of an ISO 8601 repeating interval, as in the "R5" of "R5/2024-01-01T00:00Z/P1D"
+const isoRepeat = `^R(\d*)$`
+
+// the start of an ISO 8601 date, as in "2024-01-01T00:00Z" or "20240101"
+const isoDate = `^\d{4}-?\d{2}-?\d{2}`
+
+// a Unix epoch time, as in "@1700000000", "1700000000123ms" or "1700000000.5"
+const epoch = `^(@)?(\d+)(?:\.(\d+))?(s|ms|us|µs|ns)?$`
+
+// a compact duration, as in "2h30m", "1w2d" or "-15m", spaces are removed first
+const compactDuration = `^([+-])?((?:\d+(?:\.\d+)?(?:mo|ms|us|µs|ns|y|w|d|h|m|s))+)$`
+
+// one part of a compact duration, as in "30m"
+const compactPart = `(\d+(?:\.\d+)?)(mo|ms|us|µs|ns|y|w|d|h|m|s)`
+
+// a number or time without am/pm that may borrow one, as in the 9 of "from 9 to 11am"
+const bareClock = `^\d{1,2}(?::\d{1,2}){0,2}$`
+
+// the regexs are compiled once and shared by every Humantime
+var (
+	exactTimeRegex     = regexp.MustCompile(exactTime)
+	amOrPmRegex        = regexp.MustCompile(amORpm)
+	atTimeRegex        = regexp.MustCompile(atTime)
+	numericDateRegex   = regexp.MustCompile(numericDate)
+	offsetRegex        = regexp.MustCompile(offset)
+	periodRegex        = regexp.MustCompile(period)
+	arithmeticRegex    = regexp.MustCompile(arithmetic)
+	variableRegex      = regexp.MustCompile(variable)
+	exclusionRegex     = regexp.MustCompile(exclusion)
+	listSeparatorRegex = regexp.MustCompile(listSeparator)
+	timeWindowRegex    = regexp.MustCompile(timeWindow)
+	timesBetweenRegex  = regexp.MustCompile(timesBetween)
+	countedDaysRegex   = regexp.MustCompile(countedDays)
+	throughRegex       = regexp.MustCompile(through)
+	spanDashRegex      = regexp.MustCompile(spanDash)
+	timeSpanRegex      = regexp.MustCompile(timeSpan)
+	bareClockRegex     = regexp.MustCompile(bareClock)
+	windowRegex        = regexp.MustCompile(window)
+	boundaryRegex      = regexp.MustCompile(boundary)
+	snapRegex          = regexp.MustCompile(snap)
+	snapOffsetRegex    = regexp.MustCompile(snapOffset)
+	alignmentRegex     = regexp.MustCompile(alignment)
+	isoDurationRegex   = regexp.MustCompile(isoDuration)
+	isoRepeatRegex     = regexp.MustCompile(isoRepeat)
+	isoDateRegex       = regexp.MustCompile(isoDate)
+	epochRegex         = regexp.MustCompile(epoch)
+	compactRegex       = regexp.MustCompile(compactDuration)
+	compactPartRegex   = regexp.MustCompile(compactPart)
+)
@@ -3,11 +3,11 @@ package humantime
 import (
 	"fmt"
 	"strings"
-	"time"
 )
 
 // Until takes a string starting with the words until or til
-// and parses the remainder as time.Time, examples:
+// and parses the remainder as time.Time. Days, weeks, months and years are
+// included so "until friday" ends when friday does, examples:
 // until 3/15/2022
 // until May 8, 2009 5:57:51 PM
 // until 2am
@@ -16,7 +16,7 @@ import (
 // until tomorrow at 13:34:32
 func (st *Humantime) Until(input string) (*TimeRange, error) {
 	var tr = new(TimeRange)
-	tr.From = time.Now().In(st.Location)
+	tr.From = st.now()
 
 	if len(strings.Fields(input)) < 2 {
 		return nil, fmt.Errorf("input must have at least two fields: %s", input)
@@ -24,10 +24,10 @@ func (st *Humantime) Until(input string) (*TimeRange, error) {
 	if !strings.HasPrefix(input, "until") && !strings.HasPrefix(input, "til") {
 		return nil, fmt.Errorf("input does not start with 'until': %s", input)
 	}
-	input = strings.ReplaceAll(input, "until ", "")
-	input = strings.ReplaceAll(input, "til ", "")
+	input = strings.TrimPrefix(input, "until ")
+	input = strings.TrimPrefix(input, "til ")
 
-	var err error
-	tr.To, err = st.parseDatePhrase(input)
+	var date, precision, err = st.preferring(PreferFuture).parsePhrase(input)
+	tr.To = st.periodEnd(date, precision)
 	return tr, err
 }
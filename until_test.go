@@ -8,19 +8,21 @@ import (
 )
 
 var TestUntilTestCases = map[string]time.Time{
-	"until 3/15/2026":              time.Date(2026, time.Month(3), 15, 0, 0, 0, 0, today.Location()),
+	"until 3/15/2026":              time.Date(2026, time.Month(3), 16, 0, 0, 0, 0, today.Location()),
 	"until May 8, 2009 5:57:51 PM": time.Date(2009, time.Month(5), 8, 17, 57, 51, 0, today.Location()),
-	"until tomorrow":               time.Date(today.Year(), today.Month(), today.Day()+1, 0, 0, 0, 0, today.Location()),
+	"until tomorrow":               time.Date(today.Year(), today.Month(), today.Day()+2, 0, 0, 0, 0, today.Location()),
 	"until tomorrow at 4pm":        time.Date(today.Year(), today.Month(), today.Day()+1, 16, 0, 0, 0, today.Location()),
 	"until tomorrow at 13:34:32":   time.Date(today.Year(), today.Month(), today.Day()+1, 13, 34, 32, 0, today.Location()),
-	"until 2pm":                    time.Date(today.Year(), today.Month(), today.Day(), 14, 00, 00, 0, today.Location()),
+	"until January 2025":           time.Date(2025, time.February, 1, 0, 0, 0, 0, today.Location()),
+	"until 2024-03":                time.Date(2024, time.April, 1, 0, 0, 0, 0, today.Location()),
+	"until 2020":                   time.Date(2021, time.January, 1, 0, 0, 0, 0, today.Location()),
 }
 
 func TestUntil(t *testing.T) {
 	t.Parallel()
 
 	var now = time.Now()
-	var st, err = NewString2Time(now.Location())
+	var st, err = New(WithLocation(now.Location()))
 	assert.NoError(t, err)
 
 	for input, expected := range TestUntilTestCases {
@@ -30,7 +32,16 @@ func TestUntil(t *testing.T) {
 		assert.Equal(t, now.Round(time.Second), result.From.Round(time.Second))
 	}
 
-	result, err := st.Until("ago ")
+	// a bare time of day is resolved against the clock, 10:30 in fixedNow
+	var _, fixed = fixedNow(t)
+	result, err := fixed.Until("until 2pm")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 6, 14, 0, 0, 0, time.UTC), result.To)
+	result, err = fixed.Until("until 9am")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 7, 9, 0, 0, 0, time.UTC), result.To)
+
+	result, err = st.Until("ago ")
 	assert.Equal(t, "input must have at least two fields: ago ", err.Error())
 	assert.Nil(t, result)
 
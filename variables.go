@@ -0,0 +1,38 @@
+package humantime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// UnboundVariableError is returned when a phrase uses a $variable that was
+// not passed to ParseWithVars
+type UnboundVariableError struct {
+	// Name of the variable without the $, lower cased
+	Name string
+	// Input is the date phrase the variable was found in
+	Input string
+}
+
+func (e *UnboundVariableError) Error() string {
+	return fmt.Sprintf("unbound variable $%s in input: %s", e.Name, e.Input)
+}
+
+// ParseWithVars is the same as Parse but binds $variables for this call only.
+// Variables work anywhere a date phrase does and names are case insensitive:
+//
+//	st.ParseWithVars("from $start - 15 minutes to $end + 15 minutes", map[string]time.Time{
+//		"start": alert.Start,
+//		"end":   alert.End,
+//	})
+//
+// A variable missing from vars returns an *UnboundVariableError.
+func (st *Humantime) ParseWithVars(input string, vars map[string]time.Time) (*TimeRange, error) {
+	var ht = *st
+	ht.vars = make(map[string]time.Time, len(vars))
+	for name, value := range vars {
+		ht.vars[strings.ToLower(strings.TrimPrefix(name, "$"))] = value
+	}
+	return ht.Parse(input)
+}
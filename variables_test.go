@@ -0,0 +1,65 @@
+package humantime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWithVars(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var vars = map[string]time.Time{
+		"start":   time.Date(2024, time.March, 5, 8, 0, 0, 0, time.UTC),
+		"End":     time.Date(2024, time.March, 5, 9, 0, 0, 0, time.UTC),
+		"$deploy": time.Date(2024, time.March, 4, 22, 10, 0, 0, time.UTC),
+	}
+
+	var cases = map[string]TimeRange{
+		"from $start - 15 minutes to $end + 15 minutes": {
+			From: time.Date(2024, time.March, 5, 7, 45, 0, 0, time.UTC),
+			To:   time.Date(2024, time.March, 5, 9, 15, 0, 0, time.UTC),
+		},
+		"since 2 hours after $deploy": {
+			From: time.Date(2024, time.March, 5, 0, 10, 0, 0, time.UTC),
+			To:   now,
+		},
+		"since $DEPLOY + 1 day - 30 mins": {
+			From: time.Date(2024, time.March, 5, 21, 40, 0, 0, time.UTC),
+			To:   now,
+		},
+		"from $start to $end": {
+			From: time.Date(2024, time.March, 5, 8, 0, 0, 0, time.UTC),
+			To:   time.Date(2024, time.March, 5, 9, 0, 0, 0, time.UTC),
+		},
+		"until $deploy at 11pm": {
+			From: now,
+			To:   time.Date(2024, time.March, 4, 23, 0, 0, 0, time.UTC),
+		},
+		"from yesterday at 3pm + 2 hours to today": {
+			From: time.Date(2024, time.March, 5, 17, 0, 0, 0, time.UTC),
+			To:   time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for input, expected := range cases {
+		result, err := st.ParseWithVars(input, vars)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+
+	// variables are only bound for the call
+	_, err := st.Parse("since $start")
+	var unbound *UnboundVariableError
+	assert.True(t, errors.As(err, &unbound))
+	assert.Equal(t, "start", unbound.Name)
+
+	result, err := st.ParseWithVars("from $start to $finish + 1 hour", vars)
+	assert.True(t, errors.As(err, &unbound))
+	assert.Equal(t, "finish", unbound.Name)
+	assert.Equal(t, "error parsingDatePhrase: unbound variable $finish in input: $finish", err.Error())
+	assert.Nil(t, result)
+}
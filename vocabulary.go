@@ -0,0 +1,314 @@
+package humantime
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Vocabulary holds the words a Humantime understands: units of time, synonyms
+// like "yesterday", weekday names and named anchors like "code freeze".
+// A Vocabulary never changes once built, the Add methods return a modified
+// copy so it is safe to share.
+type Vocabulary struct {
+	units    map[string]Duration
+	synonyms map[string]func(now time.Time) time.Time
+	weekdays map[string]time.Weekday
+	anchors  map[string]anchorFunc
+
+	synonymRegex *regexp.Regexp
+	weekdayRegex *regexp.Regexp
+	anchorRegex  *regexp.Regexp
+}
+
+// defaultUnits is the template for the units of every Vocabulary
+var defaultUnits = map[string]Duration{
+	"second":  {Clock: time.Second},
+	"seconds": {Clock: time.Second},
+	"sec":     {Clock: time.Second},
+	"secs":    {Clock: time.Second},
+	"minute":  {Clock: time.Minute},
+	"minutes": {Clock: time.Minute},
+	"min":     {Clock: time.Minute},
+	"mins":    {Clock: time.Minute},
+	"hour":    {Clock: time.Hour},
+	"hours":   {Clock: time.Hour},
+	"hr":      {Clock: time.Hour},
+	"hrs":     {Clock: time.Hour},
+	"day":     {Days: 1},
+	"days":    {Days: 1},
+	"week":    {Days: 7},
+	"weeks":   {Days: 7},
+	"month":   {Months: 1},
+	"months":  {Months: 1},
+	"year":    {Years: 1},
+	"years":   {Years: 1},
+}
+
+// defaultSynonyms is the template for the synonyms of every Vocabulary,
+// now is already in the location of the Humantime
+var defaultSynonyms = map[string]func(now time.Time) time.Time{
+	"now": func(now time.Time) time.Time {
+		return now
+	},
+	"yesterday": func(now time.Time) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, now.Location())
+	},
+	"today": func(now time.Time) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	},
+	"tomorrow": func(now time.Time) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+	},
+}
+
+// defaultWeekdays is the template for the weekday names of every Vocabulary
+var defaultWeekdays = map[string]time.Weekday{
+	"monday":    time.Monday,
+	"mon":       time.Monday,
+	"mo":        time.Monday,
+	"tuesday":   time.Tuesday,
+	"tues":      time.Tuesday,
+	"tue":       time.Tuesday,
+	"tu":        time.Tuesday,
+	"wednesday": time.Wednesday,
+	"weds":      time.Wednesday,
+	"wed":       time.Wednesday,
+	"we":        time.Wednesday,
+	"thursday":  time.Thursday,
+	"thurs":     time.Thursday,
+	"thur":      time.Thursday,
+	"thu":       time.Thursday,
+	"th":        time.Thursday,
+	"friday":    time.Friday,
+	"fri":       time.Friday,
+	"fr":        time.Friday,
+	"saturday":  time.Saturday,
+	"sat":       time.Saturday,
+	"sa":        time.Saturday,
+	"sunday":    time.Sunday,
+	"sun":       time.Sunday,
+	"su":        time.Sunday,
+}
+
+// languages maps the codes accepted by WithLanguage to their vocabulary
+var languages = map[string]*Vocabulary{
+	"en": (&Vocabulary{units: defaultUnits, synonyms: defaultSynonyms, weekdays: defaultWeekdays}).clone().compile(),
+}
+
+// DefaultVocabulary returns the English vocabulary every Humantime starts with
+func DefaultVocabulary() *Vocabulary {
+	return languages["en"]
+}
+
+// clone copies the maps so the copy can be modified, call compile once done
+func (v *Vocabulary) clone() *Vocabulary {
+	var c = &Vocabulary{
+		units:    make(map[string]Duration, len(v.units)),
+		synonyms: make(map[string]func(now time.Time) time.Time, len(v.synonyms)),
+		weekdays: make(map[string]time.Weekday, len(v.weekdays)),
+		anchors:  make(map[string]anchorFunc, len(v.anchors)),
+	}
+	for word, u := range v.units {
+		c.units[word] = u
+	}
+	for word, fn := range v.synonyms {
+		c.synonyms[word] = fn
+	}
+	for word, day := range v.weekdays {
+		c.weekdays[word] = day
+	}
+	for name, fn := range v.anchors {
+		c.anchors[name] = fn
+	}
+	return c
+}
+
+// compile builds the regexs matching the words in the maps
+func (v *Vocabulary) compile() *Vocabulary {
+	v.synonymRegex = wordsRegex(`\b(%s)\b`, v.synonyms)
+	v.weekdayRegex = wordsRegex(`\b(?:(`+weekdayModifiers+`)\s+)?(%s)\b`, v.weekdays)
+	v.anchorRegex = wordsRegex(`\b(%s)\b`, v.anchors)
+	return v
+}
+
+// matchNothing stands in for the regex of an empty word list, an empty
+// alternation would match everywhere
+var matchNothing = regexp.MustCompile(`[^\x00-\x{10FFFF}]`)
+
+// wordsRegex compiles format with the keys of words as an alternation in place of %s
+func wordsRegex[T any](format string, words map[string]T) *regexp.Regexp {
+	if len(words) == 0 {
+		return matchNothing
+	}
+	var keys = make([]string, 0, len(words))
+	for word := range words {
+		keys = append(keys, word)
+	}
+	return regexp.MustCompile(fmt.Sprintf(format, wordsPattern(keys)))
+}
+
+// wordsPattern joins the words into a regex alternation. Longer words are tried
+// first so "thursday" is not cut short by "thu".
+func wordsPattern(words []string) string {
+	var quoted = make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = strings.ReplaceAll(regexp.QuoteMeta(word), " ", `\s+`)
+	}
+	sort.Slice(quoted, func(i, j int) bool {
+		if len(quoted[i]) != len(quoted[j]) {
+			return len(quoted[i]) > len(quoted[j])
+		}
+		return quoted[i] < quoted[j]
+	})
+	return strings.Join(quoted, "|")
+}
+
+// normalizeWord lower cases the word and collapses its spaces, the way input is
+// normalized before it is matched
+func normalizeWord(word string) (string, error) {
+	word = strings.Join(strings.Fields(strings.ToLower(word)), " ")
+	if word == "" {
+		return "", errors.New("word cannot be empty")
+	}
+	return word, nil
+}
+
+// AddSynonym returns a copy of the vocabulary where word resolves to fn(now),
+// e.g. "payday". Existing words are replaced.
+func (v *Vocabulary) AddSynonym(word string, fn func(now time.Time) time.Time) (*Vocabulary, error) {
+	word, err := normalizeWord(word)
+	if err != nil {
+		return nil, err
+	}
+	if fn == nil {
+		return nil, fmt.Errorf("synonym %s cannot have a nil func", word)
+	}
+
+	var c = v.clone()
+	c.synonyms[word] = fn
+	return c.compile(), nil
+}
+
+// AddUnit returns a copy of the vocabulary where word is a unit of d, e.g.
+// "fortnight". Whole days are added on the calendar like the built in day unit.
+func (v *Vocabulary) AddUnit(word string, d time.Duration) (*Vocabulary, error) {
+	word, err := normalizeWord(word)
+	if err != nil {
+		return nil, err
+	}
+	if d <= 0 {
+		return nil, fmt.Errorf("unit %s must be positive: %s", word, d)
+	}
+
+	const day = 24 * time.Hour
+	var c = v.clone()
+	c.units[word] = Duration{Days: int(d / day), Clock: d % day}
+	return c.compile(), nil
+}
+
+// AddWeekdayAlias returns a copy of the vocabulary where alias names the day,
+// e.g. "fri-yay"
+func (v *Vocabulary) AddWeekdayAlias(alias string, day time.Weekday) (*Vocabulary, error) {
+	alias, err := normalizeWord(alias)
+	if err != nil {
+		return nil, err
+	}
+	if day < time.Sunday || day > time.Saturday {
+		return nil, fmt.Errorf("invalid weekday for %s: %d", alias, day)
+	}
+
+	var c = v.clone()
+	c.weekdays[alias] = day
+	return c.compile(), nil
+}
+
+// AddAnchor returns a copy of the vocabulary where name resolves to fn(now),
+// e.g. "code freeze". Anchors are matched before any other word.
+func (v *Vocabulary) AddAnchor(name string, fn func(now time.Time) time.Time) (*Vocabulary, error) {
+	name, err := normalizeWord(name)
+	if err != nil {
+		return nil, err
+	}
+	if fn == nil {
+		return nil, fmt.Errorf("anchor %s cannot have a nil func", name)
+	}
+	return v.addAnchor(name, func(now time.Time) (time.Time, error) { return fn(now), nil })
+}
+
+// anchorFunc resolves an anchor, anchors loaded from date phrases parse them
+// each time so they can fail
+type anchorFunc func(now time.Time) (time.Time, error)
+
+// addAnchor is AddAnchor for a func that can fail, name is already normalized
+func (v *Vocabulary) addAnchor(name string, fn anchorFunc) (*Vocabulary, error) {
+	var c = v.clone()
+	c.anchors[name] = fn
+	return c.compile(), nil
+}
+
+// vocabHolder lets a Humantime swap its vocabulary while other goroutines parse,
+// readers never lock and writers are serialized so no registration is lost
+type vocabHolder struct {
+	mu      sync.Mutex
+	current atomic.Pointer[Vocabulary]
+}
+
+func newVocabHolder(v *Vocabulary) *vocabHolder {
+	var holder = new(vocabHolder)
+	holder.current.Store(v)
+	return holder
+}
+
+func (h *vocabHolder) load() *Vocabulary {
+	return h.current.Load()
+}
+
+func (h *vocabHolder) update(change func(*Vocabulary) (*Vocabulary, error)) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var v, err = change(h.current.Load())
+	if err != nil {
+		return err
+	}
+	h.current.Store(v)
+	return nil
+}
+
+// swap stores v if the vocabulary is still old, for changes worked out
+// without holding the lock
+func (h *vocabHolder) swap(old, v *Vocabulary) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.current.CompareAndSwap(old, v)
+}
+
+// Vocabulary returns the current vocabulary of the Humantime
+func (st *Humantime) Vocabulary() *Vocabulary {
+	return st.vocab.load()
+}
+
+// AddSynonym registers a synonym on this Humantime only, it is safe to call
+// while other goroutines are parsing
+func (st *Humantime) AddSynonym(word string, fn func(now time.Time) time.Time) error {
+	return st.vocab.update(func(v *Vocabulary) (*Vocabulary, error) { return v.AddSynonym(word, fn) })
+}
+
+// AddUnit registers a unit of time on this Humantime only, it is safe to call
+// while other goroutines are parsing
+func (st *Humantime) AddUnit(word string, d time.Duration) error {
+	return st.vocab.update(func(v *Vocabulary) (*Vocabulary, error) { return v.AddUnit(word, d) })
+}
+
+// AddWeekdayAlias registers a weekday name on this Humantime only, it is safe
+// to call while other goroutines are parsing
+func (st *Humantime) AddWeekdayAlias(alias string, day time.Weekday) error {
+	return st.vocab.update(func(v *Vocabulary) (*Vocabulary, error) { return v.AddWeekdayAlias(alias, day) })
+}
@@ -0,0 +1,124 @@
+package humantime
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVocabulary(t *testing.T) {
+	t.Parallel()
+
+	var payday = func(now time.Time) time.Time {
+		return time.Date(now.Year(), now.Month(), 15, 0, 0, 0, 0, now.Location())
+	}
+
+	// copy on write
+	var base = DefaultVocabulary()
+	withPayday, err := base.AddSynonym("  Pay   Day ", payday)
+	assert.NoError(t, err)
+	assert.Contains(t, withPayday.synonyms, "pay day")
+	assert.NotContains(t, base.synonyms, "pay day")
+	assert.NotContains(t, DefaultVocabulary().synonyms, "pay day")
+
+	withFortnight, err := base.AddUnit("fortnights", 14*24*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, Duration{Days: 14}, withFortnight.units["fortnights"])
+	assert.NotContains(t, base.units, "fortnights")
+
+	withShift, err := base.AddUnit("shift", 8*time.Hour+30*time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, Duration{Clock: 8*time.Hour + 30*time.Minute}, withShift.units["shift"])
+
+	withFriyay, err := base.AddWeekdayAlias("friyay", time.Friday)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Friday, withFriyay.weekdays["friyay"])
+	assert.NotContains(t, base.weekdays, "friyay")
+
+	// errors
+	_, err = base.AddSynonym(" ", payday)
+	assert.Equal(t, "word cannot be empty", err.Error())
+	_, err = base.AddSynonym("payday", nil)
+	assert.Equal(t, "synonym payday cannot have a nil func", err.Error())
+	_, err = base.AddUnit("nothing", 0)
+	assert.Equal(t, "unit nothing must be positive: 0s", err.Error())
+	_, err = base.AddWeekdayAlias("someday", 9)
+	assert.Equal(t, "invalid weekday for someday: 9", err.Error())
+
+	// used by a Humantime
+	var _, st = fixedNow(t, WithVocabulary(withPayday))
+	result, err := st.Parse("since pay day at 9am")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 15, 9, 0, 0, 0, time.UTC), result.From)
+
+	_, err = New(WithVocabulary(nil))
+	assert.Equal(t, "vocabulary cannot be nil", err.Error())
+}
+
+func TestHumantimeVocabulary(t *testing.T) {
+	t.Parallel()
+
+	var _, a = fixedNow(t)
+	var _, b = fixedNow(t)
+
+	assert.NoError(t, a.AddUnit("fortnight", 14*24*time.Hour))
+	assert.NoError(t, a.AddWeekdayAlias("friyay", time.Friday))
+	assert.NoError(t, a.AddSynonym("the big day", func(now time.Time) time.Time {
+		return time.Date(2024, time.June, 1, 0, 0, 0, 0, now.Location())
+	}))
+
+	result, err := a.Parse("1 fortnight 2 hours ago")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.February, 21, 8, 30, 0, 0, time.UTC), result.From)
+
+	result, err = a.Parse("until next friyay")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC), result.To)
+
+	result, err = a.Parse("until the big day at 5pm")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.June, 1, 17, 0, 0, 0, time.UTC), result.To)
+
+	// other instances do not see the registrations
+	_, err = b.Parse("1 fortnight ago")
+	assert.Error(t, err)
+	_, err = b.Parse("until next friyay")
+	assert.Error(t, err)
+	assert.NotContains(t, b.Vocabulary().synonyms, "the big day")
+
+	assert.Error(t, a.AddUnit("", time.Hour))
+}
+
+func TestConcurrentRegistration(t *testing.T) {
+	t.Parallel()
+
+	var st, err = New(WithLocation(time.UTC))
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, st.AddSynonym(fmt.Sprintf("day%d", i), func(now time.Time) time.Time { return now }))
+			assert.NoError(t, st.AddUnit(fmt.Sprintf("unit%d", i), time.Duration(i+1)*time.Minute))
+		}(i)
+		go func() {
+			defer wg.Done()
+			var _, err = st.Parse("since yesterday at 3pm")
+			assert.NoError(t, err)
+			_, err = st.Parse("2 hours ago")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// no registration is lost
+	for i := 0; i < 20; i++ {
+		assert.Contains(t, st.Vocabulary().synonyms, fmt.Sprintf("day%d", i))
+		assert.Contains(t, st.Vocabulary().units, fmt.Sprintf("unit%d", i))
+	}
+}
@@ -0,0 +1,50 @@
+package humantime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Window takes a rolling window of time that ends now, or starts now for
+// "next" and "coming", examples:
+// past 24 hours
+// last 7 days
+// the next 3 weeks
+// previous 90 minutes
+// last hour
+// Calendar periods are not windows: "last week" is the week before this one
+// while "last 7 days" and "past week" are the seven days up to now.
+func (st *Humantime) Window(input string) (*TimeRange, error) {
+	var tr, found = st.rollingWindow(input)
+	if !found {
+		return nil, fmt.Errorf("input is not a window like 'past 24 hours': %s", input)
+	}
+	return tr, nil
+}
+
+// rollingWindow reads the window, found is false when the input is not one
+func (ht *Humantime) rollingWindow(input string) (*TimeRange, bool) {
+	var match = windowRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(input)))
+	if match == nil {
+		return nil, false
+	}
+
+	// "last week" is a calendar period, "last hour" and "past week" are windows
+	if _, period := periods[match[2]]; period && (match[1] == "last" || match[1] == "next") {
+		return nil, false
+	}
+	var vocab = ht.vocab.load()
+	var u, ok = vocab.parseLength(match[2])
+	if !ok && len(strings.Fields(match[2])) == 1 {
+		u, ok = vocab.parseLength("1 " + match[2])
+	}
+	if !ok {
+		return nil, false
+	}
+
+	var now = ht.now()
+	if match[1] == "next" || match[1] == "coming" {
+		return &TimeRange{From: now, To: u.AddTo(now)}, true
+	}
+	return &TimeRange{From: u.SubFrom(now), To: now}, true
+}
@@ -0,0 +1,50 @@
+package humantime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindow(t *testing.T) {
+	t.Parallel()
+
+	var now, st = fixedNow(t)
+
+	var cases = map[string]TimeRange{
+		"past 24 hours":              {From: now.Add(-24 * time.Hour), To: now},
+		"last 7 days":                {From: time.Date(2024, time.February, 28, 10, 30, 0, 0, time.UTC), To: now},
+		"previous 90 minutes":        {From: now.Add(-90 * time.Minute), To: now},
+		"last hour":                  {From: now.Add(-time.Hour), To: now},
+		"past week":                  {From: time.Date(2024, time.February, 28, 10, 30, 0, 0, time.UTC), To: now},
+		"the last 2 months":          {From: time.Date(2024, time.January, 6, 10, 30, 0, 0, time.UTC), To: now},
+		"last 1 hour and 30 minutes": {From: now.Add(-90 * time.Minute), To: now},
+		"the next 3 weeks":           {From: now, To: time.Date(2024, time.March, 27, 10, 30, 0, 0, time.UTC)},
+		"next 2 days":                {From: now, To: time.Date(2024, time.March, 8, 10, 30, 0, 0, time.UTC)},
+		"coming hour":                {From: now, To: now.Add(time.Hour)},
+	}
+
+	for input, expected := range cases {
+		var result, err = st.Window(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+
+		result, err = st.Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, *result, input)
+	}
+
+	// calendar periods and weekdays are not windows
+	for _, input := range []string{"last week", "next month", "last friday", "past 3 fridays", "last 3"} {
+		var result, err = st.Window(input)
+		assert.Equal(t, "input is not a window like 'past 24 hours': "+input, err.Error())
+		assert.Nil(t, result)
+	}
+
+	// with units registered on the Humantime
+	assert.NoError(t, st.AddUnit("fortnight", 14*24*time.Hour))
+	result, err := st.Parse("last fortnight")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeRange{From: time.Date(2024, time.February, 21, 10, 30, 0, 0, time.UTC), To: now}, *result)
+}